@@ -23,15 +23,22 @@ const (
 type Error int32
 
 const (
-	Error_ERROR_UNSPECIFIED           Error = 0
-	Error_ERROR_AUTH_ERROR            Error = 100
-	Error_ERROR_TOKEN_HEADER_REQUIRED Error = 101
-	Error_ERROR_TOKEN_INVALID         Error = 102
-	Error_ERROR_TOKEN_ERROR           Error = 103
-	Error_ERROR_NOT_FOUND             Error = 104
-	Error_ERROR_INVALID_ARGS          Error = 105
-	Error_ERROR_INVALID_ID            Error = 106
-	Error_ERROR_INTERNAL_ERROR        Error = 107
+	Error_ERROR_UNSPECIFIED            Error = 0
+	Error_ERROR_AUTH_ERROR             Error = 100
+	Error_ERROR_TOKEN_HEADER_REQUIRED  Error = 101
+	Error_ERROR_TOKEN_INVALID          Error = 102
+	Error_ERROR_TOKEN_ERROR            Error = 103
+	Error_ERROR_NOT_FOUND              Error = 104
+	Error_ERROR_INVALID_ARGS           Error = 105
+	Error_ERROR_INVALID_ID             Error = 106
+	Error_ERROR_INTERNAL_ERROR         Error = 107
+	Error_ERROR_TRANSFER_WINDOW_CLOSED Error = 108
+	Error_ERROR_ALREADY_EXISTS         Error = 109
+	Error_ERROR_TOKEN_REVOKED          Error = 110
+	Error_ERROR_FORBIDDEN              Error = 111
+	Error_ERROR_ACCOUNT_FROZEN         Error = 112
+	Error_ERROR_TOO_MANY_ATTEMPTS      Error = 113
+	Error_ERROR_RATE_LIMITED           Error = 114
 )
 
 // Enum value maps for Error.
@@ -46,17 +53,31 @@ var (
 		105: "ERROR_INVALID_ARGS",
 		106: "ERROR_INVALID_ID",
 		107: "ERROR_INTERNAL_ERROR",
+		108: "ERROR_TRANSFER_WINDOW_CLOSED",
+		109: "ERROR_ALREADY_EXISTS",
+		110: "ERROR_TOKEN_REVOKED",
+		111: "ERROR_FORBIDDEN",
+		112: "ERROR_ACCOUNT_FROZEN",
+		113: "ERROR_TOO_MANY_ATTEMPTS",
+		114: "ERROR_RATE_LIMITED",
 	}
 	Error_value = map[string]int32{
-		"ERROR_UNSPECIFIED":           0,
-		"ERROR_AUTH_ERROR":            100,
-		"ERROR_TOKEN_HEADER_REQUIRED": 101,
-		"ERROR_TOKEN_INVALID":         102,
-		"ERROR_TOKEN_ERROR":           103,
-		"ERROR_NOT_FOUND":             104,
-		"ERROR_INVALID_ARGS":          105,
-		"ERROR_INVALID_ID":            106,
-		"ERROR_INTERNAL_ERROR":        107,
+		"ERROR_UNSPECIFIED":            0,
+		"ERROR_AUTH_ERROR":             100,
+		"ERROR_TOKEN_HEADER_REQUIRED":  101,
+		"ERROR_TOKEN_INVALID":          102,
+		"ERROR_TOKEN_ERROR":            103,
+		"ERROR_NOT_FOUND":              104,
+		"ERROR_INVALID_ARGS":           105,
+		"ERROR_INVALID_ID":             106,
+		"ERROR_INTERNAL_ERROR":         107,
+		"ERROR_TRANSFER_WINDOW_CLOSED": 108,
+		"ERROR_ALREADY_EXISTS":         109,
+		"ERROR_TOKEN_REVOKED":          110,
+		"ERROR_FORBIDDEN":              111,
+		"ERROR_ACCOUNT_FROZEN":         112,
+		"ERROR_TOO_MANY_ATTEMPTS":      113,
+		"ERROR_RATE_LIMITED":           114,
 	}
 )
 
@@ -151,7 +172,7 @@ var file_error_proto_rawDesc = []byte{
 	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x72,
 	0x72, 0x6f, 0x72, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2a, 0xe2, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x15, 0x0a,
+	0x61, 0x67, 0x65, 0x2a, 0x9b, 0x03, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x15, 0x0a,
 	0x11, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
 	0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x41, 0x55,
 	0x54, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52,
@@ -165,9 +186,20 @@ var file_error_proto_rawDesc = []byte{
 	0x44, 0x5f, 0x41, 0x52, 0x47, 0x53, 0x10, 0x69, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x4f,
 	0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x6a, 0x12, 0x18,
 	0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x6b, 0x42, 0x14, 0x5a, 0x12, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2d, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x6b, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x6c, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53,
+	0x54, 0x53, 0x10, 0x6d, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x4f,
+	0x4b, 0x45, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x6e, 0x12, 0x13, 0x0a,
+	0x0f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e,
+	0x10, 0x6f, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x70, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x41,
+	0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x53, 0x10, 0x71, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10,
+	0x72, 0x42, 0x14, 0x5a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d, 0x76, 0x31,
+	0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
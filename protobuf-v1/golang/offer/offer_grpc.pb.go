@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: offer/offer.proto
+
+package offer
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OfferServiceClient is the client API for OfferService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OfferServiceClient interface {
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Offer, error)
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*Offer, error)
+	Respond(ctx context.Context, in *RespondRequest, opts ...grpc.CallOption) (*Offer, error)
+	GetIncoming(ctx context.Context, in *GetByTeamRequest, opts ...grpc.CallOption) (*Offers, error)
+	GetOutgoing(ctx context.Context, in *GetByTeamRequest, opts ...grpc.CallOption) (*Offers, error)
+}
+
+type offerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOfferServiceClient(cc grpc.ClientConnInterface) OfferServiceClient {
+	return &offerServiceClient{cc}
+}
+
+func (c *offerServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Offer, error) {
+	out := new(Offer)
+	err := c.cc.Invoke(ctx, "/protobuf.offer.OfferService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *offerServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*Offer, error) {
+	out := new(Offer)
+	err := c.cc.Invoke(ctx, "/protobuf.offer.OfferService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *offerServiceClient) Respond(ctx context.Context, in *RespondRequest, opts ...grpc.CallOption) (*Offer, error) {
+	out := new(Offer)
+	err := c.cc.Invoke(ctx, "/protobuf.offer.OfferService/Respond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *offerServiceClient) GetIncoming(ctx context.Context, in *GetByTeamRequest, opts ...grpc.CallOption) (*Offers, error) {
+	out := new(Offers)
+	err := c.cc.Invoke(ctx, "/protobuf.offer.OfferService/GetIncoming", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *offerServiceClient) GetOutgoing(ctx context.Context, in *GetByTeamRequest, opts ...grpc.CallOption) (*Offers, error) {
+	out := new(Offers)
+	err := c.cc.Invoke(ctx, "/protobuf.offer.OfferService/GetOutgoing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OfferServiceServer is the server API for OfferService service.
+// All implementations must embed UnimplementedOfferServiceServer
+// for forward compatibility
+type OfferServiceServer interface {
+	Get(context.Context, *GetRequest) (*Offer, error)
+	Create(context.Context, *CreateRequest) (*Offer, error)
+	Respond(context.Context, *RespondRequest) (*Offer, error)
+	GetIncoming(context.Context, *GetByTeamRequest) (*Offers, error)
+	GetOutgoing(context.Context, *GetByTeamRequest) (*Offers, error)
+	mustEmbedUnimplementedOfferServiceServer()
+}
+
+// UnimplementedOfferServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOfferServiceServer struct {
+}
+
+func (UnimplementedOfferServiceServer) Get(context.Context, *GetRequest) (*Offer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedOfferServiceServer) Create(context.Context, *CreateRequest) (*Offer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedOfferServiceServer) Respond(context.Context, *RespondRequest) (*Offer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Respond not implemented")
+}
+func (UnimplementedOfferServiceServer) GetIncoming(context.Context, *GetByTeamRequest) (*Offers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncoming not implemented")
+}
+func (UnimplementedOfferServiceServer) GetOutgoing(context.Context, *GetByTeamRequest) (*Offers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutgoing not implemented")
+}
+func (UnimplementedOfferServiceServer) mustEmbedUnimplementedOfferServiceServer() {}
+
+// UnsafeOfferServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OfferServiceServer will
+// result in compilation errors.
+type UnsafeOfferServiceServer interface {
+	mustEmbedUnimplementedOfferServiceServer()
+}
+
+func RegisterOfferServiceServer(s grpc.ServiceRegistrar, srv OfferServiceServer) {
+	s.RegisterService(&OfferService_ServiceDesc, srv)
+}
+
+func _OfferService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfferServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.offer.OfferService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfferServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OfferService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfferServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.offer.OfferService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfferServiceServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OfferService_Respond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfferServiceServer).Respond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.offer.OfferService/Respond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfferServiceServer).Respond(ctx, req.(*RespondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OfferService_GetIncoming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfferServiceServer).GetIncoming(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.offer.OfferService/GetIncoming",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfferServiceServer).GetIncoming(ctx, req.(*GetByTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OfferService_GetOutgoing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfferServiceServer).GetOutgoing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.offer.OfferService/GetOutgoing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfferServiceServer).GetOutgoing(ctx, req.(*GetByTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OfferService_ServiceDesc is the grpc.ServiceDesc for OfferService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OfferService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protobuf.offer.OfferService",
+	HandlerType: (*OfferServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _OfferService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _OfferService_Create_Handler,
+		},
+		{
+			MethodName: "Respond",
+			Handler:    _OfferService_Respond_Handler,
+		},
+		{
+			MethodName: "GetIncoming",
+			Handler:    _OfferService_GetIncoming_Handler,
+		},
+		{
+			MethodName: "GetOutgoing",
+			Handler:    _OfferService_GetOutgoing_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "offer/offer.proto",
+}
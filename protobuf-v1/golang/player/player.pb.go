@@ -9,6 +9,7 @@ package player
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	golang "protobuf-v1/golang"
 	reflect "reflect"
@@ -77,28 +78,361 @@ func (PlayerType) EnumDescriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{0}
 }
 
+type ListingType int32
+
+const (
+	ListingType_LT_UNSPECIFIED    ListingType = 0
+	ListingType_LT_FIXED_PRICE    ListingType = 1
+	ListingType_LT_OPEN_AUCTION   ListingType = 2
+	ListingType_LT_SEALED_AUCTION ListingType = 3
+	ListingType_LT_LOAN           ListingType = 4
+)
+
+// Enum value maps for ListingType.
+var (
+	ListingType_name = map[int32]string{
+		0: "LT_UNSPECIFIED",
+		1: "LT_FIXED_PRICE",
+		2: "LT_OPEN_AUCTION",
+		3: "LT_SEALED_AUCTION",
+		4: "LT_LOAN",
+	}
+	ListingType_value = map[string]int32{
+		"LT_UNSPECIFIED":    0,
+		"LT_FIXED_PRICE":    1,
+		"LT_OPEN_AUCTION":   2,
+		"LT_SEALED_AUCTION": 3,
+		"LT_LOAN":           4,
+	}
+)
+
+func (x ListingType) Enum() *ListingType {
+	p := new(ListingType)
+	*p = x
+	return p
+}
+
+func (x ListingType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListingType) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_player_proto_enumTypes[1].Descriptor()
+}
+
+func (ListingType) Type() protoreflect.EnumType {
+	return &file_player_player_proto_enumTypes[1]
+}
+
+func (x ListingType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListingType.Descriptor instead.
+func (ListingType) EnumDescriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{1}
+}
+
+type PlayerSortField int32
+
+const (
+	PlayerSortField_PSF_UNSPECIFIED PlayerSortField = 0
+	PlayerSortField_PSF_ASK_VALUE   PlayerSortField = 1
+	PlayerSortField_PSF_VALUE       PlayerSortField = 2
+	PlayerSortField_PSF_AGE         PlayerSortField = 3
+	PlayerSortField_PSF_OVERALL     PlayerSortField = 4
+)
+
+// Enum value maps for PlayerSortField.
+var (
+	PlayerSortField_name = map[int32]string{
+		0: "PSF_UNSPECIFIED",
+		1: "PSF_ASK_VALUE",
+		2: "PSF_VALUE",
+		3: "PSF_AGE",
+		4: "PSF_OVERALL",
+	}
+	PlayerSortField_value = map[string]int32{
+		"PSF_UNSPECIFIED": 0,
+		"PSF_ASK_VALUE":   1,
+		"PSF_VALUE":       2,
+		"PSF_AGE":         3,
+		"PSF_OVERALL":     4,
+	}
+)
+
+func (x PlayerSortField) Enum() *PlayerSortField {
+	p := new(PlayerSortField)
+	*p = x
+	return p
+}
+
+func (x PlayerSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_player_proto_enumTypes[2].Descriptor()
+}
+
+func (PlayerSortField) Type() protoreflect.EnumType {
+	return &file_player_player_proto_enumTypes[2]
+}
+
+func (x PlayerSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerSortField.Descriptor instead.
+func (PlayerSortField) EnumDescriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{2}
+}
+
+type PlayerHistoryType int32
+
+const (
+	PlayerHistoryType_PHT_UNSPECIFIED      PlayerHistoryType = 0
+	PlayerHistoryType_PHT_JOINED           PlayerHistoryType = 1
+	PlayerHistoryType_PHT_TRANSFER         PlayerHistoryType = 2
+	PlayerHistoryType_PHT_VALUE_CHANGE     PlayerHistoryType = 3
+	PlayerHistoryType_PHT_LOAN             PlayerHistoryType = 4
+	PlayerHistoryType_PHT_LOAN_RETURN      PlayerHistoryType = 5
+	PlayerHistoryType_PHT_CONTRACT_RENEWAL PlayerHistoryType = 6
+)
+
+// Enum value maps for PlayerHistoryType.
+var (
+	PlayerHistoryType_name = map[int32]string{
+		0: "PHT_UNSPECIFIED",
+		1: "PHT_JOINED",
+		2: "PHT_TRANSFER",
+		3: "PHT_VALUE_CHANGE",
+		4: "PHT_LOAN",
+		5: "PHT_LOAN_RETURN",
+		6: "PHT_CONTRACT_RENEWAL",
+	}
+	PlayerHistoryType_value = map[string]int32{
+		"PHT_UNSPECIFIED":      0,
+		"PHT_JOINED":           1,
+		"PHT_TRANSFER":         2,
+		"PHT_VALUE_CHANGE":     3,
+		"PHT_LOAN":             4,
+		"PHT_LOAN_RETURN":      5,
+		"PHT_CONTRACT_RENEWAL": 6,
+	}
+)
+
+func (x PlayerHistoryType) Enum() *PlayerHistoryType {
+	p := new(PlayerHistoryType)
+	*p = x
+	return p
+}
+
+func (x PlayerHistoryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerHistoryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_player_proto_enumTypes[3].Descriptor()
+}
+
+func (PlayerHistoryType) Type() protoreflect.EnumType {
+	return &file_player_player_proto_enumTypes[3]
+}
+
+func (x PlayerHistoryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerHistoryType.Descriptor instead.
+func (PlayerHistoryType) EnumDescriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{3}
+}
+
+type BidStatus int32
+
+const (
+	BidStatus_BS_UNSPECIFIED BidStatus = 0
+	BidStatus_BS_HELD        BidStatus = 1
+	BidStatus_BS_WON         BidStatus = 2
+	BidStatus_BS_LOST        BidStatus = 3
+	BidStatus_BS_OUTBID      BidStatus = 4
+)
+
+// Enum value maps for BidStatus.
+var (
+	BidStatus_name = map[int32]string{
+		0: "BS_UNSPECIFIED",
+		1: "BS_HELD",
+		2: "BS_WON",
+		3: "BS_LOST",
+		4: "BS_OUTBID",
+	}
+	BidStatus_value = map[string]int32{
+		"BS_UNSPECIFIED": 0,
+		"BS_HELD":        1,
+		"BS_WON":         2,
+		"BS_LOST":        3,
+		"BS_OUTBID":      4,
+	}
+)
+
+func (x BidStatus) Enum() *BidStatus {
+	p := new(BidStatus)
+	*p = x
+	return p
+}
+
+func (x BidStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BidStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_player_proto_enumTypes[4].Descriptor()
+}
+
+func (BidStatus) Type() protoreflect.EnumType {
+	return &file_player_player_proto_enumTypes[4]
+}
+
+func (x BidStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BidStatus.Descriptor instead.
+func (BidStatus) EnumDescriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{4}
+}
+
+// PlayerSkills are ratings from 1 to 100, overall weighs the skills that matter for the player's position
+type PlayerSkills struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pace        int32 `protobuf:"varint,1,opt,name=pace,proto3" json:"pace,omitempty"`
+	Shooting    int32 `protobuf:"varint,2,opt,name=shooting,proto3" json:"shooting,omitempty"`
+	Passing     int32 `protobuf:"varint,3,opt,name=passing,proto3" json:"passing,omitempty"`
+	Defending   int32 `protobuf:"varint,4,opt,name=defending,proto3" json:"defending,omitempty"`
+	Goalkeeping int32 `protobuf:"varint,5,opt,name=goalkeeping,proto3" json:"goalkeeping,omitempty"`
+	Stamina     int32 `protobuf:"varint,6,opt,name=stamina,proto3" json:"stamina,omitempty"`
+	Overall     int32 `protobuf:"varint,7,opt,name=overall,proto3" json:"overall,omitempty"`
+}
+
+func (x *PlayerSkills) Reset() {
+	*x = PlayerSkills{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_player_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerSkills) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSkills) ProtoMessage() {}
+
+func (x *PlayerSkills) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSkills.ProtoReflect.Descriptor instead.
+func (*PlayerSkills) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlayerSkills) GetPace() int32 {
+	if x != nil {
+		return x.Pace
+	}
+	return 0
+}
+
+func (x *PlayerSkills) GetShooting() int32 {
+	if x != nil {
+		return x.Shooting
+	}
+	return 0
+}
+
+func (x *PlayerSkills) GetPassing() int32 {
+	if x != nil {
+		return x.Passing
+	}
+	return 0
+}
+
+func (x *PlayerSkills) GetDefending() int32 {
+	if x != nil {
+		return x.Defending
+	}
+	return 0
+}
+
+func (x *PlayerSkills) GetGoalkeeping() int32 {
+	if x != nil {
+		return x.Goalkeeping
+	}
+	return 0
+}
+
+func (x *PlayerSkills) GetStamina() int32 {
+	if x != nil {
+		return x.Stamina
+	}
+	return 0
+}
+
+func (x *PlayerSkills) GetOverall() int32 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
 type Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Age       int32                  `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
-	Type      PlayerType             `protobuf:"varint,5,opt,name=type,proto3,enum=protobuf.player.PlayerType" json:"type,omitempty"`
-	Country   string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
-	TeamId    string                 `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	Value     int64                  `protobuf:"varint,8,opt,name=value,proto3" json:"value,omitempty"`
-	IsListed  bool                   `protobuf:"varint,9,opt,name=is_listed,json=isListed,proto3" json:"is_listed,omitempty"`
-	AskValue  *wrapperspb.Int64Value `protobuf:"bytes,10,opt,name=ask_value,json=askValue,proto3" json:"ask_value,omitempty"`
-	Currency  golang.Currency        `protobuf:"varint,11,opt,name=currency,proto3,enum=protobuf.Currency" json:"currency,omitempty"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName      string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Age            int32                  `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
+	Type           PlayerType             `protobuf:"varint,5,opt,name=type,proto3,enum=protobuf.player.PlayerType" json:"type,omitempty"`
+	Country        string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	TeamId         string                 `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Value          int64                  `protobuf:"varint,8,opt,name=value,proto3" json:"value,omitempty"`
+	IsListed       bool                   `protobuf:"varint,9,opt,name=is_listed,json=isListed,proto3" json:"is_listed,omitempty"`
+	AskValue       *wrapperspb.Int64Value `protobuf:"bytes,10,opt,name=ask_value,json=askValue,proto3" json:"ask_value,omitempty"`
+	Currency       golang.Currency        `protobuf:"varint,11,opt,name=currency,proto3,enum=protobuf.Currency" json:"currency,omitempty"`
+	ListingType    ListingType            `protobuf:"varint,12,opt,name=listing_type,json=listingType,proto3,enum=protobuf.player.ListingType" json:"listing_type,omitempty"`
+	ReservePrice   *wrapperspb.Int64Value `protobuf:"bytes,13,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	AuctionEndsAt  *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=auction_ends_at,json=auctionEndsAt,proto3" json:"auction_ends_at,omitempty"`
+	HighestBid     *wrapperspb.Int64Value `protobuf:"bytes,15,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	BidCount       int32                  `protobuf:"varint,16,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`
+	LoanWeeks      int32                  `protobuf:"varint,17,opt,name=loan_weeks,json=loanWeeks,proto3" json:"loan_weeks,omitempty"`
+	LoanId         string                 `protobuf:"bytes,18,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	ParentTeamId   string                 `protobuf:"bytes,19,opt,name=parent_team_id,json=parentTeamId,proto3" json:"parent_team_id,omitempty"`
+	Wage           int64                  `protobuf:"varint,20,opt,name=wage,proto3" json:"wage,omitempty"`
+	ContractEndsAt *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=contract_ends_at,json=contractEndsAt,proto3" json:"contract_ends_at,omitempty"`
+	Skills         *PlayerSkills          `protobuf:"bytes,22,opt,name=skills,proto3" json:"skills,omitempty"`
 }
 
 func (x *Player) Reset() {
 	*x = Player{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_player_player_proto_msgTypes[0]
+		mi := &file_player_player_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -111,7 +445,7 @@ func (x *Player) String() string {
 func (*Player) ProtoMessage() {}
 
 func (x *Player) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[0]
+	mi := &file_player_player_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -124,7 +458,7 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Player.ProtoReflect.Descriptor instead.
 func (*Player) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{0}
+	return file_player_player_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Player) GetId() string {
@@ -204,19 +538,97 @@ func (x *Player) GetCurrency() golang.Currency {
 	return golang.Currency(0)
 }
 
+func (x *Player) GetListingType() ListingType {
+	if x != nil {
+		return x.ListingType
+	}
+	return ListingType_LT_UNSPECIFIED
+}
+
+func (x *Player) GetReservePrice() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return nil
+}
+
+func (x *Player) GetAuctionEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuctionEndsAt
+	}
+	return nil
+}
+
+func (x *Player) GetHighestBid() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.HighestBid
+	}
+	return nil
+}
+
+func (x *Player) GetBidCount() int32 {
+	if x != nil {
+		return x.BidCount
+	}
+	return 0
+}
+
+func (x *Player) GetLoanWeeks() int32 {
+	if x != nil {
+		return x.LoanWeeks
+	}
+	return 0
+}
+
+func (x *Player) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Player) GetParentTeamId() string {
+	if x != nil {
+		return x.ParentTeamId
+	}
+	return ""
+}
+
+func (x *Player) GetWage() int64 {
+	if x != nil {
+		return x.Wage
+	}
+	return 0
+}
+
+func (x *Player) GetContractEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ContractEndsAt
+	}
+	return nil
+}
+
+func (x *Player) GetSkills() *PlayerSkills {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
 type Players struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total   int32     `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Players []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	Total      int32     `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Players    []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	NextCursor string    `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
 func (x *Players) Reset() {
 	*x = Players{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_player_player_proto_msgTypes[1]
+		mi := &file_player_player_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -229,7 +641,7 @@ func (x *Players) String() string {
 func (*Players) ProtoMessage() {}
 
 func (x *Players) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[1]
+	mi := &file_player_player_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,7 +654,7 @@ func (x *Players) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Players.ProtoReflect.Descriptor instead.
 func (*Players) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{1}
+	return file_player_player_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Players) GetTotal() int32 {
@@ -259,6 +671,13 @@ func (x *Players) GetPlayers() []*Player {
 	return nil
 }
 
+func (x *Players) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type GetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -270,7 +689,7 @@ type GetRequest struct {
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_player_player_proto_msgTypes[2]
+		mi := &file_player_player_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -283,7 +702,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[2]
+	mi := &file_player_player_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +715,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{2}
+	return file_player_player_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetRequest) GetId() string {
@@ -306,31 +725,619 @@ func (x *GetRequest) GetId() string {
 	return ""
 }
 
-type GetByTeamRequest struct {
+// SkillFilter keeps players whose overall rating is in range and whose skills are at least the given minimums
+type SkillFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TeamId string `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	MinOverall     *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=min_overall,json=minOverall,proto3" json:"min_overall,omitempty"`
+	MaxOverall     *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=max_overall,json=maxOverall,proto3" json:"max_overall,omitempty"`
+	MinPace        *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=min_pace,json=minPace,proto3" json:"min_pace,omitempty"`
+	MinShooting    *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=min_shooting,json=minShooting,proto3" json:"min_shooting,omitempty"`
+	MinPassing     *wrapperspb.Int32Value `protobuf:"bytes,5,opt,name=min_passing,json=minPassing,proto3" json:"min_passing,omitempty"`
+	MinDefending   *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=min_defending,json=minDefending,proto3" json:"min_defending,omitempty"`
+	MinGoalkeeping *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=min_goalkeeping,json=minGoalkeeping,proto3" json:"min_goalkeeping,omitempty"`
+	MinStamina     *wrapperspb.Int32Value `protobuf:"bytes,8,opt,name=min_stamina,json=minStamina,proto3" json:"min_stamina,omitempty"`
 }
 
-func (x *GetByTeamRequest) Reset() {
-	*x = GetByTeamRequest{}
+func (x *SkillFilter) Reset() {
+	*x = SkillFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_player_player_proto_msgTypes[3]
+		mi := &file_player_player_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetByTeamRequest) String() string {
+func (x *SkillFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByTeamRequest) ProtoMessage() {}
+func (*SkillFilter) ProtoMessage() {}
 
-func (x *GetByTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[3]
+func (x *SkillFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillFilter.ProtoReflect.Descriptor instead.
+func (*SkillFilter) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SkillFilter) GetMinOverall() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinOverall
+	}
+	return nil
+}
+
+func (x *SkillFilter) GetMaxOverall() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxOverall
+	}
+	return nil
+}
+
+func (x *SkillFilter) GetMinPace() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPace
+	}
+	return nil
+}
+
+func (x *SkillFilter) GetMinShooting() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinShooting
+	}
+	return nil
+}
+
+func (x *SkillFilter) GetMinPassing() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPassing
+	}
+	return nil
+}
+
+func (x *SkillFilter) GetMinDefending() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinDefending
+	}
+	return nil
+}
+
+func (x *SkillFilter) GetMinGoalkeeping() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinGoalkeeping
+	}
+	return nil
+}
+
+func (x *SkillFilter) GetMinStamina() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinStamina
+	}
+	return nil
+}
+
+type GetByTeamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId string       `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Skills *SkillFilter `protobuf:"bytes,2,opt,name=skills,proto3" json:"skills,omitempty"`
+}
+
+func (x *GetByTeamRequest) Reset() {
+	*x = GetByTeamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_player_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByTeamRequest) ProtoMessage() {}
+
+func (x *GetByTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetByTeamRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetByTeamRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetByTeamRequest) GetSkills() *SkillFilter {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type GetListedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        PlayerType             `protobuf:"varint,1,opt,name=type,proto3,enum=protobuf.player.PlayerType" json:"type,omitempty"`
+	Country     string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	MinAge      *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	MaxAge      *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	MinAskValue *wrapperspb.Int64Value `protobuf:"bytes,5,opt,name=min_ask_value,json=minAskValue,proto3" json:"min_ask_value,omitempty"`
+	MaxAskValue *wrapperspb.Int64Value `protobuf:"bytes,6,opt,name=max_ask_value,json=maxAskValue,proto3" json:"max_ask_value,omitempty"`
+	TeamId      string                 `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Name        string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	SortBy      PlayerSortField        `protobuf:"varint,9,opt,name=sort_by,json=sortBy,proto3,enum=protobuf.player.PlayerSortField" json:"sort_by,omitempty"`
+	SortOrder   golang.SortOrder       `protobuf:"varint,10,opt,name=sort_order,json=sortOrder,proto3,enum=protobuf.SortOrder" json:"sort_order,omitempty"`
+	PageSize    int32                  `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor      string                 `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Skills      *SkillFilter           `protobuf:"bytes,13,opt,name=skills,proto3" json:"skills,omitempty"`
+}
+
+func (x *GetListedRequest) Reset() {
+	*x = GetListedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_player_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListedRequest) ProtoMessage() {}
+
+func (x *GetListedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListedRequest.ProtoReflect.Descriptor instead.
+func (*GetListedRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetListedRequest) GetType() PlayerType {
+	if x != nil {
+		return x.Type
+	}
+	return PlayerType_PT_UNSPECIFIED
+}
+
+func (x *GetListedRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *GetListedRequest) GetMinAge() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinAge
+	}
+	return nil
+}
+
+func (x *GetListedRequest) GetMaxAge() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *GetListedRequest) GetMinAskValue() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.MinAskValue
+	}
+	return nil
+}
+
+func (x *GetListedRequest) GetMaxAskValue() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.MaxAskValue
+	}
+	return nil
+}
+
+func (x *GetListedRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetListedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetListedRequest) GetSortBy() PlayerSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return PlayerSortField_PSF_UNSPECIFIED
+}
+
+func (x *GetListedRequest) GetSortOrder() golang.SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return golang.SortOrder(0)
+}
+
+func (x *GetListedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetListedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetListedRequest) GetSkills() *SkillFilter {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type UpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	IsListed      *wrapperspb.BoolValue  `protobuf:"bytes,5,opt,name=is_listed,json=isListed,proto3" json:"is_listed,omitempty"`
+	AskValue      *wrapperspb.Int64Value `protobuf:"bytes,6,opt,name=ask_value,json=askValue,proto3" json:"ask_value,omitempty"`
+	Value         *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	ListingType   ListingType            `protobuf:"varint,8,opt,name=listing_type,json=listingType,proto3,enum=protobuf.player.ListingType" json:"listing_type,omitempty"`
+	ReservePrice  *wrapperspb.Int64Value `protobuf:"bytes,9,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	AuctionEndsAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=auction_ends_at,json=auctionEndsAt,proto3" json:"auction_ends_at,omitempty"`
+	LoanWeeks     *wrapperspb.Int32Value `protobuf:"bytes,11,opt,name=loan_weeks,json=loanWeeks,proto3" json:"loan_weeks,omitempty"`
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_player_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetIsListed() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IsListed
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetAskValue() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.AskValue
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetValue() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetListingType() ListingType {
+	if x != nil {
+		return x.ListingType
+	}
+	return ListingType_LT_UNSPECIFIED
+}
+
+func (x *UpdateRequest) GetReservePrice() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetAuctionEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuctionEndsAt
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetLoanWeeks() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.LoanWeeks
+	}
+	return nil
+}
+
+type Bid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerId  string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TeamId    string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Amount    int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status    BidStatus              `protobuf:"varint,5,opt,name=status,proto3,enum=protobuf.player.BidStatus" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Currency  golang.Currency        `protobuf:"varint,8,opt,name=currency,proto3,enum=protobuf.Currency" json:"currency,omitempty"`
+}
+
+func (x *Bid) Reset() {
+	*x = Bid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_player_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bid) ProtoMessage() {}
+
+func (x *Bid) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bid.ProtoReflect.Descriptor instead.
+func (*Bid) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Bid) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bid) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *Bid) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *Bid) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Bid) GetStatus() BidStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BidStatus_BS_UNSPECIFIED
+}
+
+func (x *Bid) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Bid) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Bid) GetCurrency() golang.Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return golang.Currency(0)
+}
+
+type Bids struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int32  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Bids  []*Bid `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+}
+
+func (x *Bids) Reset() {
+	*x = Bids{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_player_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bids) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bids) ProtoMessage() {}
+
+func (x *Bids) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bids.ProtoReflect.Descriptor instead.
+func (*Bids) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Bids) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Bids) GetBids() []*Bid {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+type GetBidsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TeamId   string `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+}
+
+func (x *GetBidsRequest) Reset() {
+	*x = GetBidsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_player_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBidsRequest) ProtoMessage() {}
+
+func (x *GetBidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -341,41 +1348,63 @@ func (x *GetByTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByTeamRequest.ProtoReflect.Descriptor instead.
-func (*GetByTeamRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetBidsRequest.ProtoReflect.Descriptor instead.
+func (*GetBidsRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetByTeamRequest) GetTeamId() string {
+func (x *GetBidsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetBidsRequest) GetTeamId() string {
 	if x != nil {
 		return x.TeamId
 	}
 	return ""
 }
 
-type GetListedRequest struct {
+type PlayerHistoryEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerId   string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Type       PlayerHistoryType      `protobuf:"varint,3,opt,name=type,proto3,enum=protobuf.player.PlayerHistoryType" json:"type,omitempty"`
+	FromTeamId string                 `protobuf:"bytes,4,opt,name=from_team_id,json=fromTeamId,proto3" json:"from_team_id,omitempty"`
+	ToTeamId   string                 `protobuf:"bytes,5,opt,name=to_team_id,json=toTeamId,proto3" json:"to_team_id,omitempty"`
+	TransferId string                 `protobuf:"bytes,6,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Fee        int64                  `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	OldValue   int64                  `protobuf:"varint,8,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue   int64                  `protobuf:"varint,9,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Currency   golang.Currency        `protobuf:"varint,11,opt,name=currency,proto3,enum=protobuf.Currency" json:"currency,omitempty"`
+	LoanId     string                 `protobuf:"bytes,12,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	OldWage    int64                  `protobuf:"varint,13,opt,name=old_wage,json=oldWage,proto3" json:"old_wage,omitempty"`
+	NewWage    int64                  `protobuf:"varint,14,opt,name=new_wage,json=newWage,proto3" json:"new_wage,omitempty"`
 }
 
-func (x *GetListedRequest) Reset() {
-	*x = GetListedRequest{}
+func (x *PlayerHistoryEntry) Reset() {
+	*x = PlayerHistoryEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_player_player_proto_msgTypes[4]
+		mi := &file_player_player_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetListedRequest) String() string {
+func (x *PlayerHistoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetListedRequest) ProtoMessage() {}
+func (*PlayerHistoryEntry) ProtoMessage() {}
 
-func (x *GetListedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[4]
+func (x *PlayerHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,42 +1415,135 @@ func (x *GetListedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetListedRequest.ProtoReflect.Descriptor instead.
-func (*GetListedRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use PlayerHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PlayerHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{11}
 }
 
-type UpdateRequest struct {
+func (x *PlayerHistoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayerHistoryEntry) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerHistoryEntry) GetType() PlayerHistoryType {
+	if x != nil {
+		return x.Type
+	}
+	return PlayerHistoryType_PHT_UNSPECIFIED
+}
+
+func (x *PlayerHistoryEntry) GetFromTeamId() string {
+	if x != nil {
+		return x.FromTeamId
+	}
+	return ""
+}
+
+func (x *PlayerHistoryEntry) GetToTeamId() string {
+	if x != nil {
+		return x.ToTeamId
+	}
+	return ""
+}
+
+func (x *PlayerHistoryEntry) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *PlayerHistoryEntry) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *PlayerHistoryEntry) GetOldValue() int64 {
+	if x != nil {
+		return x.OldValue
+	}
+	return 0
+}
+
+func (x *PlayerHistoryEntry) GetNewValue() int64 {
+	if x != nil {
+		return x.NewValue
+	}
+	return 0
+}
+
+func (x *PlayerHistoryEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PlayerHistoryEntry) GetCurrency() golang.Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return golang.Currency(0)
+}
+
+func (x *PlayerHistoryEntry) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *PlayerHistoryEntry) GetOldWage() int64 {
+	if x != nil {
+		return x.OldWage
+	}
+	return 0
+}
+
+func (x *PlayerHistoryEntry) GetNewWage() int64 {
+	if x != nil {
+		return x.NewWage
+	}
+	return 0
+}
+
+type PlayerHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Country   string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	IsListed  *wrapperspb.BoolValue  `protobuf:"bytes,5,opt,name=is_listed,json=isListed,proto3" json:"is_listed,omitempty"`
-	AskValue  *wrapperspb.Int64Value `protobuf:"bytes,6,opt,name=ask_value,json=askValue,proto3" json:"ask_value,omitempty"`
-	Value     *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	Total   int32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Entries []*PlayerHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *UpdateRequest) Reset() {
-	*x = UpdateRequest{}
+func (x *PlayerHistory) Reset() {
+	*x = PlayerHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_player_player_proto_msgTypes[5]
+		mi := &file_player_player_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateRequest) String() string {
+func (x *PlayerHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRequest) ProtoMessage() {}
+func (*PlayerHistory) ProtoMessage() {}
 
-func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[5]
+func (x *PlayerHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,58 +1554,70 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PlayerHistory.ProtoReflect.Descriptor instead.
+func (*PlayerHistory) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UpdateRequest) GetId() string {
+func (x *PlayerHistory) GetTotal() int32 {
 	if x != nil {
-		return x.Id
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateRequest) GetFirstName() string {
+func (x *PlayerHistory) GetEntries() []*PlayerHistoryEntry {
 	if x != nil {
-		return x.FirstName
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateRequest) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+type GetHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 }
 
-func (x *UpdateRequest) GetCountry() string {
-	if x != nil {
-		return x.Country
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_player_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UpdateRequest) GetIsListed() *wrapperspb.BoolValue {
-	if x != nil {
-		return x.IsListed
-	}
-	return nil
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateRequest) GetAskValue() *wrapperspb.Int64Value {
-	if x != nil {
-		return x.AskValue
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateRequest) GetValue() *wrapperspb.Int64Value {
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetHistoryRequest) GetPlayerId() string {
 	if x != nil {
-		return x.Value
+		return x.PlayerId
 	}
-	return nil
+	return ""
 }
 
 var File_player_player_proto protoreflect.FileDescriptor
@@ -493,87 +1627,333 @@ var file_player_player_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12,
-	0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65,
-	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61,
-	0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x22, 0x52, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22,
-	0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x9b, 0x02, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x69,
-	0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x6b,
+	0x69, 0x6c, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b,
+	0x67, 0x6f, 0x61, 0x6c, 0x6b, 0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x6b, 0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x6c, 0x6c, 0x22, 0xf8, 0x06, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x0c,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x42, 0x0a, 0x0f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62,
+	0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x57, 0x65, 0x65, 0x6b, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x77, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x77, 0x61, 0x67, 0x65,
+	0x12, 0x44, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x6e, 0x64,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x22, 0x73, 0x0a,
+	0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31,
+	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x85, 0x04, 0x0a, 0x0b, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x3c, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x3c,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x36, 0x0a, 0x08,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x31,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x50, 0x61, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x68, 0x6f, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x68, 0x6f, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x12, 0x40, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x66, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x44, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x6f, 0x61, 0x6c,
+	0x6b, 0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x2a, 0x6a, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x0e, 0x50, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x54, 0x5f, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x4b,
-	0x45, 0x45, 0x50, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x54, 0x5f, 0x44, 0x45,
-	0x46, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x54, 0x5f, 0x4d,
-	0x49, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b,
-	0x50, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b, 0x45, 0x52, 0x10, 0x04, 0x32, 0xa3, 0x02,
-	0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x3b, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x06,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
-	0x48, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x42, 0x1b, 0x5a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d,
-	0x76, 0x31, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x47,
+	0x6f, 0x61, 0x6c, 0x6b, 0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x6d, 0x69,
+	0x6e, 0x53, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x22, 0x61, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x22, 0xd2, 0x04, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x6d,
+	0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x41, 0x67,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
+	0x73, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
+	0x41, 0x73, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x73, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x41, 0x73, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x6b,
+	0x69, 0x6c, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x6b, 0x69,
+	0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x22, 0x9e, 0x04, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f,
+	0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x0f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x77, 0x65,
+	0x65, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x57, 0x65, 0x65, 0x6b,
+	0x73, 0x22, 0xbd, 0x02, 0x0a, 0x03, 0x42, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x46, 0x0a, 0x04, 0x42, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x28, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
+	0x42, 0x69, 0x64, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x22, 0x46, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x22, 0xe0, 0x03, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x77, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x6f, 0x6c, 0x64, 0x57, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77,
+	0x5f, 0x77, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65, 0x77,
+	0x57, 0x61, 0x67, 0x65, 0x22, 0x64, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x6a, 0x0a, 0x0a,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x54, 0x5f, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x4e, 0x44, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x54, 0x5f, 0x4d, 0x49, 0x44, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x54, 0x5f, 0x41, 0x54,
+	0x54, 0x41, 0x43, 0x4b, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x6e, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x54, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c,
+	0x54, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x4c, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x54, 0x5f, 0x53, 0x45, 0x41, 0x4c, 0x45,
+	0x44, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4c,
+	0x54, 0x5f, 0x4c, 0x4f, 0x41, 0x4e, 0x10, 0x04, 0x2a, 0x66, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x50,
+	0x53, 0x46, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x11, 0x0a, 0x0d, 0x50, 0x53, 0x46, 0x5f, 0x41, 0x53, 0x4b, 0x5f, 0x56, 0x41, 0x4c, 0x55,
+	0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x53, 0x46, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x53, 0x46, 0x5f, 0x41, 0x47, 0x45, 0x10, 0x03, 0x12,
+	0x0f, 0x0a, 0x0b, 0x50, 0x53, 0x46, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x4c, 0x4c, 0x10, 0x04,
+	0x2a, 0x9d, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x48, 0x54, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50,
+	0x48, 0x54, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50,
+	0x48, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x50, 0x48, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x48, 0x54, 0x5f, 0x4c, 0x4f, 0x41, 0x4e, 0x10,
+	0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x48, 0x54, 0x5f, 0x4c, 0x4f, 0x41, 0x4e, 0x5f, 0x52, 0x45,
+	0x54, 0x55, 0x52, 0x4e, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x48, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x57, 0x41, 0x4c, 0x10, 0x06,
+	0x2a, 0x54, 0x0a, 0x09, 0x42, 0x69, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x0e, 0x42, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x53, 0x5f, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x42, 0x53, 0x5f, 0x57, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x53,
+	0x5f, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x53, 0x5f, 0x4f, 0x55,
+	0x54, 0x42, 0x49, 0x44, 0x10, 0x04, 0x32, 0xb8, 0x03, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x54, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x42, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x64, 0x73, 0x12,
+	0x50, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x42, 0x1b, 0x5a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d, 0x76, 0x31,
+	0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -588,41 +1968,96 @@ func file_player_player_proto_rawDescGZIP() []byte {
 	return file_player_player_proto_rawDescData
 }
 
-var file_player_player_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_player_player_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_player_player_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_player_player_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_player_player_proto_goTypes = []interface{}{
 	(PlayerType)(0),               // 0: protobuf.player.PlayerType
-	(*Player)(nil),                // 1: protobuf.player.Player
-	(*Players)(nil),               // 2: protobuf.player.Players
-	(*GetRequest)(nil),            // 3: protobuf.player.GetRequest
-	(*GetByTeamRequest)(nil),      // 4: protobuf.player.GetByTeamRequest
-	(*GetListedRequest)(nil),      // 5: protobuf.player.GetListedRequest
-	(*UpdateRequest)(nil),         // 6: protobuf.player.UpdateRequest
-	(*wrapperspb.Int64Value)(nil), // 7: google.protobuf.Int64Value
-	(golang.Currency)(0),          // 8: protobuf.Currency
-	(*wrapperspb.BoolValue)(nil),  // 9: google.protobuf.BoolValue
+	(ListingType)(0),              // 1: protobuf.player.ListingType
+	(PlayerSortField)(0),          // 2: protobuf.player.PlayerSortField
+	(PlayerHistoryType)(0),        // 3: protobuf.player.PlayerHistoryType
+	(BidStatus)(0),                // 4: protobuf.player.BidStatus
+	(*PlayerSkills)(nil),          // 5: protobuf.player.PlayerSkills
+	(*Player)(nil),                // 6: protobuf.player.Player
+	(*Players)(nil),               // 7: protobuf.player.Players
+	(*GetRequest)(nil),            // 8: protobuf.player.GetRequest
+	(*SkillFilter)(nil),           // 9: protobuf.player.SkillFilter
+	(*GetByTeamRequest)(nil),      // 10: protobuf.player.GetByTeamRequest
+	(*GetListedRequest)(nil),      // 11: protobuf.player.GetListedRequest
+	(*UpdateRequest)(nil),         // 12: protobuf.player.UpdateRequest
+	(*Bid)(nil),                   // 13: protobuf.player.Bid
+	(*Bids)(nil),                  // 14: protobuf.player.Bids
+	(*GetBidsRequest)(nil),        // 15: protobuf.player.GetBidsRequest
+	(*PlayerHistoryEntry)(nil),    // 16: protobuf.player.PlayerHistoryEntry
+	(*PlayerHistory)(nil),         // 17: protobuf.player.PlayerHistory
+	(*GetHistoryRequest)(nil),     // 18: protobuf.player.GetHistoryRequest
+	(*wrapperspb.Int64Value)(nil), // 19: google.protobuf.Int64Value
+	(golang.Currency)(0),          // 20: protobuf.Currency
+	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
+	(*wrapperspb.Int32Value)(nil), // 22: google.protobuf.Int32Value
+	(golang.SortOrder)(0),         // 23: protobuf.SortOrder
+	(*wrapperspb.BoolValue)(nil),  // 24: google.protobuf.BoolValue
 }
 var file_player_player_proto_depIdxs = []int32{
 	0,  // 0: protobuf.player.Player.type:type_name -> protobuf.player.PlayerType
-	7,  // 1: protobuf.player.Player.ask_value:type_name -> google.protobuf.Int64Value
-	8,  // 2: protobuf.player.Player.currency:type_name -> protobuf.Currency
-	1,  // 3: protobuf.player.Players.players:type_name -> protobuf.player.Player
-	9,  // 4: protobuf.player.UpdateRequest.is_listed:type_name -> google.protobuf.BoolValue
-	7,  // 5: protobuf.player.UpdateRequest.ask_value:type_name -> google.protobuf.Int64Value
-	7,  // 6: protobuf.player.UpdateRequest.value:type_name -> google.protobuf.Int64Value
-	3,  // 7: protobuf.player.PlayerService.Get:input_type -> protobuf.player.GetRequest
-	6,  // 8: protobuf.player.PlayerService.Update:input_type -> protobuf.player.UpdateRequest
-	4,  // 9: protobuf.player.PlayerService.GetByTeam:input_type -> protobuf.player.GetByTeamRequest
-	5,  // 10: protobuf.player.PlayerService.GetListed:input_type -> protobuf.player.GetListedRequest
-	1,  // 11: protobuf.player.PlayerService.Get:output_type -> protobuf.player.Player
-	1,  // 12: protobuf.player.PlayerService.Update:output_type -> protobuf.player.Player
-	2,  // 13: protobuf.player.PlayerService.GetByTeam:output_type -> protobuf.player.Players
-	2,  // 14: protobuf.player.PlayerService.GetListed:output_type -> protobuf.player.Players
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	19, // 1: protobuf.player.Player.ask_value:type_name -> google.protobuf.Int64Value
+	20, // 2: protobuf.player.Player.currency:type_name -> protobuf.Currency
+	1,  // 3: protobuf.player.Player.listing_type:type_name -> protobuf.player.ListingType
+	19, // 4: protobuf.player.Player.reserve_price:type_name -> google.protobuf.Int64Value
+	21, // 5: protobuf.player.Player.auction_ends_at:type_name -> google.protobuf.Timestamp
+	19, // 6: protobuf.player.Player.highest_bid:type_name -> google.protobuf.Int64Value
+	21, // 7: protobuf.player.Player.contract_ends_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: protobuf.player.Player.skills:type_name -> protobuf.player.PlayerSkills
+	6,  // 9: protobuf.player.Players.players:type_name -> protobuf.player.Player
+	22, // 10: protobuf.player.SkillFilter.min_overall:type_name -> google.protobuf.Int32Value
+	22, // 11: protobuf.player.SkillFilter.max_overall:type_name -> google.protobuf.Int32Value
+	22, // 12: protobuf.player.SkillFilter.min_pace:type_name -> google.protobuf.Int32Value
+	22, // 13: protobuf.player.SkillFilter.min_shooting:type_name -> google.protobuf.Int32Value
+	22, // 14: protobuf.player.SkillFilter.min_passing:type_name -> google.protobuf.Int32Value
+	22, // 15: protobuf.player.SkillFilter.min_defending:type_name -> google.protobuf.Int32Value
+	22, // 16: protobuf.player.SkillFilter.min_goalkeeping:type_name -> google.protobuf.Int32Value
+	22, // 17: protobuf.player.SkillFilter.min_stamina:type_name -> google.protobuf.Int32Value
+	9,  // 18: protobuf.player.GetByTeamRequest.skills:type_name -> protobuf.player.SkillFilter
+	0,  // 19: protobuf.player.GetListedRequest.type:type_name -> protobuf.player.PlayerType
+	22, // 20: protobuf.player.GetListedRequest.min_age:type_name -> google.protobuf.Int32Value
+	22, // 21: protobuf.player.GetListedRequest.max_age:type_name -> google.protobuf.Int32Value
+	19, // 22: protobuf.player.GetListedRequest.min_ask_value:type_name -> google.protobuf.Int64Value
+	19, // 23: protobuf.player.GetListedRequest.max_ask_value:type_name -> google.protobuf.Int64Value
+	2,  // 24: protobuf.player.GetListedRequest.sort_by:type_name -> protobuf.player.PlayerSortField
+	23, // 25: protobuf.player.GetListedRequest.sort_order:type_name -> protobuf.SortOrder
+	9,  // 26: protobuf.player.GetListedRequest.skills:type_name -> protobuf.player.SkillFilter
+	24, // 27: protobuf.player.UpdateRequest.is_listed:type_name -> google.protobuf.BoolValue
+	19, // 28: protobuf.player.UpdateRequest.ask_value:type_name -> google.protobuf.Int64Value
+	19, // 29: protobuf.player.UpdateRequest.value:type_name -> google.protobuf.Int64Value
+	1,  // 30: protobuf.player.UpdateRequest.listing_type:type_name -> protobuf.player.ListingType
+	19, // 31: protobuf.player.UpdateRequest.reserve_price:type_name -> google.protobuf.Int64Value
+	21, // 32: protobuf.player.UpdateRequest.auction_ends_at:type_name -> google.protobuf.Timestamp
+	22, // 33: protobuf.player.UpdateRequest.loan_weeks:type_name -> google.protobuf.Int32Value
+	4,  // 34: protobuf.player.Bid.status:type_name -> protobuf.player.BidStatus
+	21, // 35: protobuf.player.Bid.created_at:type_name -> google.protobuf.Timestamp
+	21, // 36: protobuf.player.Bid.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 37: protobuf.player.Bid.currency:type_name -> protobuf.Currency
+	13, // 38: protobuf.player.Bids.bids:type_name -> protobuf.player.Bid
+	3,  // 39: protobuf.player.PlayerHistoryEntry.type:type_name -> protobuf.player.PlayerHistoryType
+	21, // 40: protobuf.player.PlayerHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
+	20, // 41: protobuf.player.PlayerHistoryEntry.currency:type_name -> protobuf.Currency
+	16, // 42: protobuf.player.PlayerHistory.entries:type_name -> protobuf.player.PlayerHistoryEntry
+	8,  // 43: protobuf.player.PlayerService.Get:input_type -> protobuf.player.GetRequest
+	12, // 44: protobuf.player.PlayerService.Update:input_type -> protobuf.player.UpdateRequest
+	10, // 45: protobuf.player.PlayerService.GetByTeam:input_type -> protobuf.player.GetByTeamRequest
+	11, // 46: protobuf.player.PlayerService.GetListed:input_type -> protobuf.player.GetListedRequest
+	15, // 47: protobuf.player.PlayerService.GetBids:input_type -> protobuf.player.GetBidsRequest
+	18, // 48: protobuf.player.PlayerService.GetHistory:input_type -> protobuf.player.GetHistoryRequest
+	6,  // 49: protobuf.player.PlayerService.Get:output_type -> protobuf.player.Player
+	6,  // 50: protobuf.player.PlayerService.Update:output_type -> protobuf.player.Player
+	7,  // 51: protobuf.player.PlayerService.GetByTeam:output_type -> protobuf.player.Players
+	7,  // 52: protobuf.player.PlayerService.GetListed:output_type -> protobuf.player.Players
+	14, // 53: protobuf.player.PlayerService.GetBids:output_type -> protobuf.player.Bids
+	17, // 54: protobuf.player.PlayerService.GetHistory:output_type -> protobuf.player.PlayerHistory
+	49, // [49:55] is the sub-list for method output_type
+	43, // [43:49] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_player_player_proto_init() }
@@ -632,7 +2067,7 @@ func file_player_player_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_player_player_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Player); i {
+			switch v := v.(*PlayerSkills); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -644,7 +2079,7 @@ func file_player_player_proto_init() {
 			}
 		}
 		file_player_player_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Players); i {
+			switch v := v.(*Player); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -656,7 +2091,7 @@ func file_player_player_proto_init() {
 			}
 		}
 		file_player_player_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRequest); i {
+			switch v := v.(*Players); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -668,7 +2103,7 @@ func file_player_player_proto_init() {
 			}
 		}
 		file_player_player_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByTeamRequest); i {
+			switch v := v.(*GetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -680,7 +2115,7 @@ func file_player_player_proto_init() {
 			}
 		}
 		file_player_player_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetListedRequest); i {
+			switch v := v.(*SkillFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -692,6 +2127,30 @@ func file_player_player_proto_init() {
 			}
 		}
 		file_player_player_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByTeamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_player_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_player_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateRequest); i {
 			case 0:
 				return &v.state
@@ -703,14 +2162,86 @@ func file_player_player_proto_init() {
 				return nil
 			}
 		}
+		file_player_player_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_player_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bids); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_player_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBidsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_player_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_player_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_player_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_player_player_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      5,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
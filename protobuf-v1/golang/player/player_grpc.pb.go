@@ -26,6 +26,8 @@ type PlayerServiceClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Player, error)
 	GetByTeam(ctx context.Context, in *GetByTeamRequest, opts ...grpc.CallOption) (*Players, error)
 	GetListed(ctx context.Context, in *GetListedRequest, opts ...grpc.CallOption) (*Players, error)
+	GetBids(ctx context.Context, in *GetBidsRequest, opts ...grpc.CallOption) (*Bids, error)
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*PlayerHistory, error)
 }
 
 type playerServiceClient struct {
@@ -72,6 +74,24 @@ func (c *playerServiceClient) GetListed(ctx context.Context, in *GetListedReques
 	return out, nil
 }
 
+func (c *playerServiceClient) GetBids(ctx context.Context, in *GetBidsRequest, opts ...grpc.CallOption) (*Bids, error) {
+	out := new(Bids)
+	err := c.cc.Invoke(ctx, "/protobuf.player.PlayerService/GetBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*PlayerHistory, error) {
+	out := new(PlayerHistory)
+	err := c.cc.Invoke(ctx, "/protobuf.player.PlayerService/GetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlayerServiceServer is the server API for PlayerService service.
 // All implementations must embed UnimplementedPlayerServiceServer
 // for forward compatibility
@@ -80,6 +100,8 @@ type PlayerServiceServer interface {
 	Update(context.Context, *UpdateRequest) (*Player, error)
 	GetByTeam(context.Context, *GetByTeamRequest) (*Players, error)
 	GetListed(context.Context, *GetListedRequest) (*Players, error)
+	GetBids(context.Context, *GetBidsRequest) (*Bids, error)
+	GetHistory(context.Context, *GetHistoryRequest) (*PlayerHistory, error)
 	mustEmbedUnimplementedPlayerServiceServer()
 }
 
@@ -99,6 +121,12 @@ func (UnimplementedPlayerServiceServer) GetByTeam(context.Context, *GetByTeamReq
 func (UnimplementedPlayerServiceServer) GetListed(context.Context, *GetListedRequest) (*Players, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetListed not implemented")
 }
+func (UnimplementedPlayerServiceServer) GetBids(context.Context, *GetBidsRequest) (*Bids, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBids not implemented")
+}
+func (UnimplementedPlayerServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*PlayerHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
 func (UnimplementedPlayerServiceServer) mustEmbedUnimplementedPlayerServiceServer() {}
 
 // UnsafePlayerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +212,42 @@ func _PlayerService_GetListed_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlayerService_GetBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).GetBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.player.PlayerService/GetBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).GetBids(ctx, req.(*GetBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.player.PlayerService/GetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlayerService_ServiceDesc is the grpc.ServiceDesc for PlayerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +271,14 @@ var PlayerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetListed",
 			Handler:    _PlayerService_GetListed_Handler,
 		},
+		{
+			MethodName: "GetBids",
+			Handler:    _PlayerService_GetBids_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _PlayerService_GetHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "player/player.proto",
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	player "protobuf-v1/golang/player"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -25,6 +26,11 @@ type TransactionServiceClient interface {
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Transaction, error)
 	Buy(ctx context.Context, in *BuyRequest, opts ...grpc.CallOption) (*Transaction, error)
 	GetByTeam(ctx context.Context, in *GetByTeamRequest, opts ...grpc.CallOption) (*Transactions, error)
+	PlaceBid(ctx context.Context, in *PlaceBidRequest, opts ...grpc.CallOption) (*player.Bid, error)
+	Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error)
+	Unlist(ctx context.Context, in *UnlistRequest, opts ...grpc.CallOption) (*player.Player, error)
+	BackfillTransfers(ctx context.Context, in *BackfillTransfersRequest, opts ...grpc.CallOption) (*BackfillTransfersResponse, error)
+	BackfillHistory(ctx context.Context, in *BackfillHistoryRequest, opts ...grpc.CallOption) (*BackfillHistoryResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -62,6 +68,51 @@ func (c *transactionServiceClient) GetByTeam(ctx context.Context, in *GetByTeamR
 	return out, nil
 }
 
+func (c *transactionServiceClient) PlaceBid(ctx context.Context, in *PlaceBidRequest, opts ...grpc.CallOption) (*player.Bid, error) {
+	out := new(player.Bid)
+	err := c.cc.Invoke(ctx, "/protobuf.transaction.TransactionService/PlaceBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error) {
+	out := new(ReconcileResponse)
+	err := c.cc.Invoke(ctx, "/protobuf.transaction.TransactionService/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) Unlist(ctx context.Context, in *UnlistRequest, opts ...grpc.CallOption) (*player.Player, error) {
+	out := new(player.Player)
+	err := c.cc.Invoke(ctx, "/protobuf.transaction.TransactionService/Unlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) BackfillTransfers(ctx context.Context, in *BackfillTransfersRequest, opts ...grpc.CallOption) (*BackfillTransfersResponse, error) {
+	out := new(BackfillTransfersResponse)
+	err := c.cc.Invoke(ctx, "/protobuf.transaction.TransactionService/BackfillTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) BackfillHistory(ctx context.Context, in *BackfillHistoryRequest, opts ...grpc.CallOption) (*BackfillHistoryResponse, error) {
+	out := new(BackfillHistoryResponse)
+	err := c.cc.Invoke(ctx, "/protobuf.transaction.TransactionService/BackfillHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility
@@ -69,6 +120,11 @@ type TransactionServiceServer interface {
 	Get(context.Context, *GetRequest) (*Transaction, error)
 	Buy(context.Context, *BuyRequest) (*Transaction, error)
 	GetByTeam(context.Context, *GetByTeamRequest) (*Transactions, error)
+	PlaceBid(context.Context, *PlaceBidRequest) (*player.Bid, error)
+	Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error)
+	Unlist(context.Context, *UnlistRequest) (*player.Player, error)
+	BackfillTransfers(context.Context, *BackfillTransfersRequest) (*BackfillTransfersResponse, error)
+	BackfillHistory(context.Context, *BackfillHistoryRequest) (*BackfillHistoryResponse, error)
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -85,6 +141,21 @@ func (UnimplementedTransactionServiceServer) Buy(context.Context, *BuyRequest) (
 func (UnimplementedTransactionServiceServer) GetByTeam(context.Context, *GetByTeamRequest) (*Transactions, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByTeam not implemented")
 }
+func (UnimplementedTransactionServiceServer) PlaceBid(context.Context, *PlaceBidRequest) (*player.Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+}
+func (UnimplementedTransactionServiceServer) Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedTransactionServiceServer) Unlist(context.Context, *UnlistRequest) (*player.Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlist not implemented")
+}
+func (UnimplementedTransactionServiceServer) BackfillTransfers(context.Context, *BackfillTransfersRequest) (*BackfillTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillTransfers not implemented")
+}
+func (UnimplementedTransactionServiceServer) BackfillHistory(context.Context, *BackfillHistoryRequest) (*BackfillHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillHistory not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 
 // UnsafeTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +223,96 @@ func _TransactionService_GetByTeam_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).PlaceBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.transaction.TransactionService/PlaceBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).PlaceBid(ctx, req.(*PlaceBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.transaction.TransactionService/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).Reconcile(ctx, req.(*ReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_Unlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).Unlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.transaction.TransactionService/Unlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).Unlist(ctx, req.(*UnlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_BackfillTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).BackfillTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.transaction.TransactionService/BackfillTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).BackfillTransfers(ctx, req.(*BackfillTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_BackfillHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).BackfillHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.transaction.TransactionService/BackfillHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).BackfillHistory(ctx, req.(*BackfillHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +332,26 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByTeam",
 			Handler:    _TransactionService_GetByTeam_Handler,
 		},
+		{
+			MethodName: "PlaceBid",
+			Handler:    _TransactionService_PlaceBid_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _TransactionService_Reconcile_Handler,
+		},
+		{
+			MethodName: "Unlist",
+			Handler:    _TransactionService_Unlist_Handler,
+		},
+		{
+			MethodName: "BackfillTransfers",
+			Handler:    _TransactionService_BackfillTransfers_Handler,
+		},
+		{
+			MethodName: "BackfillHistory",
+			Handler:    _TransactionService_BackfillHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transaction/transaction.proto",
@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion7
 type TransferServiceClient interface {
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Transfer, error)
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*Transfer, error)
+	GetWindow(ctx context.Context, in *GetWindowRequest, opts ...grpc.CallOption) (*GetWindowResponse, error)
 }
 
 type transferServiceClient struct {
@@ -52,12 +53,22 @@ func (c *transferServiceClient) Create(ctx context.Context, in *CreateRequest, o
 	return out, nil
 }
 
+func (c *transferServiceClient) GetWindow(ctx context.Context, in *GetWindowRequest, opts ...grpc.CallOption) (*GetWindowResponse, error) {
+	out := new(GetWindowResponse)
+	err := c.cc.Invoke(ctx, "/protobuf.transfer.TransferService/GetWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransferServiceServer is the server API for TransferService service.
 // All implementations must embed UnimplementedTransferServiceServer
 // for forward compatibility
 type TransferServiceServer interface {
 	Get(context.Context, *GetRequest) (*Transfer, error)
 	Create(context.Context, *CreateRequest) (*Transfer, error)
+	GetWindow(context.Context, *GetWindowRequest) (*GetWindowResponse, error)
 	mustEmbedUnimplementedTransferServiceServer()
 }
 
@@ -71,6 +82,9 @@ func (UnimplementedTransferServiceServer) Get(context.Context, *GetRequest) (*Tr
 func (UnimplementedTransferServiceServer) Create(context.Context, *CreateRequest) (*Transfer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedTransferServiceServer) GetWindow(context.Context, *GetWindowRequest) (*GetWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWindow not implemented")
+}
 func (UnimplementedTransferServiceServer) mustEmbedUnimplementedTransferServiceServer() {}
 
 // UnsafeTransferServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +134,24 @@ func _TransferService_Create_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransferService_GetWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.transfer.TransferService/GetWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetWindow(ctx, req.(*GetWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransferService_ServiceDesc is the grpc.ServiceDesc for TransferService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +167,10 @@ var TransferService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _TransferService_Create_Handler,
 		},
+		{
+			MethodName: "GetWindow",
+			Handler:    _TransferService_GetWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transfer/transfer.proto",
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"time"
+
+	ggrpc "google.golang.org/grpc"
+)
+
+// backfill rebuilds missing transfer records and joined history entries, and reports what it can not rebuild
+func main() {
+	addr := flag.String("addr", "localhost:3001", "address of the internal grpc service")
+	dryRun := flag.Bool("dry-run", false, "report what would be written without writing it")
+	force := flag.Bool("force", false, "run again when the backfill was already done")
+	timeout := flag.Duration("timeout", 30*time.Minute, "time allowed for the backfill")
+	flag.Parse()
+
+	serviceConn, err := ggrpc.Dial(*addr, ggrpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Error initializing grpc service client, err=%s", err.Error())
+	}
+	defer serviceConn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	txnClient := grpcTxn.NewTransactionServiceClient(serviceConn)
+	incomplete := false
+
+	resp, err := txnClient.BackfillTransfers(ctx, &grpcTxn.BackfillTransfersRequest{DryRun: *dryRun, Force: *force})
+	if err != nil {
+		log.Fatalf("Transfer backfill failed, err=%s", err.Error())
+	}
+	if resp.AlreadyDone {
+		fmt.Printf("transfer backfill already done at %s, run with -force to run it again\n", resp.DoneAt.AsTime().Format(time.RFC3339))
+	} else {
+		for _, gap := range resp.Gaps {
+			fmt.Printf("%s from=%s to=%s %s\n", gap.PlayerId, gap.FromTeamId, gap.ToTeamId, gap.Reason)
+		}
+		fmt.Printf("%d players checked, %d moves, %d transactions and %d ledger entries backfilled, %d gaps, dryRun=%t\n",
+			resp.Players, resp.Moves, resp.Transactions, resp.LedgerEntries, len(resp.Gaps), *dryRun)
+		incomplete = len(resp.Gaps) > 0
+	}
+
+	// the joined entries are taken from the moves, so the history is backfilled after the transfers
+	historyResp, err := txnClient.BackfillHistory(ctx, &grpcTxn.BackfillHistoryRequest{DryRun: *dryRun, Force: *force})
+	if err != nil {
+		log.Fatalf("History backfill failed, err=%s", err.Error())
+	}
+	if historyResp.AlreadyDone {
+		fmt.Printf("history backfill already done at %s, run with -force to run it again\n", historyResp.DoneAt.AsTime().Format(time.RFC3339))
+	} else {
+		for _, playerId := range historyResp.UnresolvedPlayerIds {
+			fmt.Printf("%s joined team unknown\n", playerId)
+		}
+		fmt.Printf("%d players checked, %d joined entries backfilled, %d unresolved, dryRun=%t\n",
+			historyResp.Players, historyResp.Entries, len(historyResp.UnresolvedPlayerIds), *dryRun)
+		incomplete = incomplete || len(historyResp.UnresolvedPlayerIds) > 0
+	}
+
+	if incomplete {
+		os.Exit(1)
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	grpcLoan "protobuf-v1/golang/loan"
 	grpcLogin "protobuf-v1/golang/login"
+	grpcOffer "protobuf-v1/golang/offer"
 	grpcPlayer "protobuf-v1/golang/player"
 	grpcTeam "protobuf-v1/golang/team"
 	grpcTxn "protobuf-v1/golang/transaction"
+	grpcTransfer "protobuf-v1/golang/transfer"
 	grpcUser "protobuf-v1/golang/user"
 	"soccer-manager/internal/handler"
 	"soccer-manager/util/config"
@@ -26,6 +29,7 @@ func main() {
 	cnString := fmt.Sprintf("%s:%s", config.GetString("grpc.name"), port)
 
 	logging.SetupLogging(config.GetString("log.level"))
+	router.TrustForwardedFor = config.GetBool("server.trustForwardedFor")
 
 	serviceConn, err := ggrpc.Dial(cnString, ggrpc.WithInsecure(), ggrpc.WithUnaryInterceptor(apmgrpc.NewUnaryClientInterceptor()))
 	if err != nil {
@@ -40,9 +44,18 @@ func main() {
 		Tc:  grpcTeam.NewTeamServiceClient(serviceConn),
 		Pc:  grpcPlayer.NewPlayerServiceClient(serviceConn),
 		Trc: grpcTxn.NewTransactionServiceClient(serviceConn),
+		Tfc: grpcTransfer.NewTransferServiceClient(serviceConn),
+		Oc:  grpcOffer.NewOfferServiceClient(serviceConn),
+		Lnc: grpcLoan.NewLoanServiceClient(serviceConn),
 	}
 
-	r := registerRoutes(handler.NewClientController(clients), clients.Lc)
+	ipRateLimit, rateLimit, err := newRateLimitMiddlewares()
+	if err != nil {
+		log.Fatalf("Error initializing rate limiting, err=%s", err.Error())
+	}
+
+	beforeAuth := []func(http.Handler) http.Handler{ipRateLimit}
+	r := registerRoutes(handler.NewClientController(clients), clients.Lc, beforeAuth, rateLimit)
 
 	err = r.ListenAndServeTLS(config.GetString("server.httpPort"), nil)
 	if err != nil {
@@ -50,8 +63,25 @@ func main() {
 	}
 }
 
-func registerRoutes(clientCntrl handler.ClientController, lc grpcLogin.LoginServiceClient) router.Router {
-	r := router.NewApiRouter(lc)
+// newRateLimitMiddlewares builds the per ip and per client rate limiting of the rateLimit config block
+func newRateLimitMiddlewares() (func(http.Handler) http.Handler, func(http.Handler) http.Handler, error) {
+	var conf router.RateLimitConfig
+	if err := config.UnmarshalKey("rateLimit", &conf); err != nil {
+		return nil, nil, err
+	}
+
+	var store router.RateLimitStore
+	switch conf.Store {
+	case "", "memory":
+		store = router.NewRateLimitMemoryStore()
+	default:
+		return nil, nil, fmt.Errorf("unknown rate limit store %q", conf.Store)
+	}
+	return router.RateLimitIpMiddleware(store, conf), router.RateLimitMiddleware(store, conf), nil
+}
+
+func registerRoutes(clientCntrl handler.ClientController, lc grpcLogin.LoginServiceClient, beforeAuth []func(http.Handler) http.Handler, middlewares ...func(http.Handler) http.Handler) router.Router {
+	r := router.NewApiRouter(lc, beforeAuth, middlewares...)
 
 	r.Route("/health", func(r router.Router) {
 		r.Get("/", func(w http.ResponseWriter, r *http.Request) {
@@ -67,41 +97,144 @@ func registerRoutes(clientCntrl handler.ClientController, lc grpcLogin.LoginServ
 
 	r.Route(clientCntrl.GetAPIVersionPath("/login"), func(r router.Router) {
 		r.Post("/", clientCntrl.Login)
+		r.Post("/totp", clientCntrl.VerifyTOTP)
+
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/register"), func(r router.Router) {
+		r.Post("/", clientCntrl.Register)
+	})
 
+	r.Get("/.well-known/jwks.json", clientCntrl.JWKS)
+
+	r.Route(clientCntrl.GetAPIVersionPath("/token"), func(r router.Router) {
+		r.Post("/refresh", clientCntrl.RefreshToken)
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/logout"), func(r router.Router) {
+		r.Post("/", clientCntrl.Logout)
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/password"), func(r router.Router) {
+		r.Post("/change", clientCntrl.ChangePassword)
+		r.Post("/forgot", clientCntrl.ForgotPassword)
+		r.Post("/reset", clientCntrl.ResetPassword)
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/email"), func(r router.Router) {
+		r.Post("/verification", clientCntrl.SendVerificationEmail)
+		r.Post("/verify", clientCntrl.VerifyEmail)
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/totp"), func(r router.Router) {
+		r.Post("/enroll", clientCntrl.EnrollTOTP)
+		r.Post("/confirm", clientCntrl.ConfirmTOTP)
+		r.Post("/disable", clientCntrl.DisableTOTP)
+		r.Post("/recovery-codes", clientCntrl.RegenerateRecoveryCodes)
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/apikeys"), func(r router.Router) {
+		r.Post("/", clientCntrl.CreateApiKey)
+		r.Get("/", clientCntrl.ListApiKeys)
+		r.Delete(fmt.Sprintf("/{apiKeyId:%s}", id.IDPrefixApiKey.REMatch()), clientCntrl.RevokeApiKey)
 	})
 
 	r.Route(clientCntrl.GetAPIVersionPath("/user"), func(r router.Router) {
 		r.Route(fmt.Sprintf("/{userId:%s}", id.IDPrefixUser.REMatch()), func(r router.Router) {
-			r.Get("/", clientCntrl.GetUser)
-			r.Patch("/", clientCntrl.UpdateUser)
+			self := router.RequireUser(handler.ParamUserID)
+
+			r.Get("/", clientCntrl.GetUser, self)
+			r.Patch("/", clientCntrl.UpdateUser, self)
 		})
 
 	})
 
 	r.Route(clientCntrl.GetAPIVersionPath("/team"), func(r router.Router) {
 		r.Route(fmt.Sprintf("/{teamId:%s}", id.IDPrefixTeam.REMatch()), func(r router.Router) {
-			r.Get("/", clientCntrl.GetTeam)
-			r.Patch("/", clientCntrl.UpdateTeam)
-			r.Get("/players", clientCntrl.GetPlayersByTeam)
-			r.Get("/transactions", clientCntrl.GetTransactionsByTeam)
+			teamOwner := router.RequireTeamOwner(handler.ParamTeamID)
+
+			r.Get("/", clientCntrl.GetTeam, teamOwner)
+			r.Patch("/", clientCntrl.UpdateTeam, teamOwner)
+			r.Get("/players", clientCntrl.GetPlayersByTeam, teamOwner)
+			r.Get("/transactions", clientCntrl.GetTransactionsByTeam, teamOwner)
+			r.Get("/offers/incoming", clientCntrl.GetIncomingOffers, teamOwner)
+			r.Get("/offers/outgoing", clientCntrl.GetOutgoingOffers, teamOwner)
+			r.Get("/loans/borrowed", clientCntrl.GetBorrowedLoans, teamOwner)
+			r.Get("/loans/lent", clientCntrl.GetLentLoans, teamOwner)
 		})
 
 	})
 
+	r.Route(clientCntrl.GetAdminPath(""), func(r router.Router) {
+		adminRead := router.RequirePermission(router.PermissionAdminRead)
+		adminWrite := router.RequirePermission(router.PermissionAdminWrite)
+
+		r.Route(fmt.Sprintf("/user/{userId:%s}", id.IDPrefixUser.REMatch()), func(r router.Router) {
+			r.Get("/", clientCntrl.AdminGetUser, adminRead)
+			r.Put("/role", clientCntrl.AdminSetRole, adminWrite)
+			r.Post("/freeze", clientCntrl.AdminFreezeUser, adminWrite)
+			r.Post("/unfreeze", clientCntrl.AdminUnfreezeUser, adminWrite)
+		})
+
+		r.Route(fmt.Sprintf("/team/{teamId:%s}", id.IDPrefixTeam.REMatch()), func(r router.Router) {
+			r.Get("/", clientCntrl.AdminGetTeam, adminRead)
+			r.Get("/players", clientCntrl.AdminGetTeamPlayers, adminRead)
+			r.Patch("/budget", clientCntrl.AdminUpdateBudget, adminWrite)
+		})
+
+		r.Route(fmt.Sprintf("/player/{playerId:%s}", id.IDPrefixPlayer.REMatch()), func(r router.Router) {
+			r.Post("/unlist", clientCntrl.AdminUnlistPlayer, adminWrite)
+		})
+	})
+
 	r.Route(clientCntrl.GetAPIVersionPath("/player"), func(r router.Router) {
 		r.Get("/listed", clientCntrl.GetListedPlayers)
 		r.Post("/buy", clientCntrl.BuyPlayer)
 
 		r.Route(fmt.Sprintf("/{playerId:%s}", id.IDPrefixPlayer.REMatch()), func(r router.Router) {
-			r.Get("/", clientCntrl.GetPlayer)
-			r.Patch("/", clientCntrl.UpdatePlayer)
+			playerOwner := router.RequireTeam(clientCntrl.PlayerTeams)
+
+			r.Get("/", clientCntrl.GetPlayer, playerOwner)
+			r.Patch("/", clientCntrl.UpdatePlayer, playerOwner)
+			r.Post("/bids", clientCntrl.PlaceBid)
+			r.Get("/bids", clientCntrl.GetBids, router.RequireTeam(clientCntrl.BidTeams))
+			r.Get("/history", clientCntrl.GetPlayerHistory)
 		})
 
 	})
 
 	r.Route(clientCntrl.GetAPIVersionPath("/transaction"), func(r router.Router) {
 		r.Route(fmt.Sprintf("/{txnId:%s}", id.IDPrefixTransaction.REMatch()), func(r router.Router) {
-			r.Get("/", clientCntrl.GetTransaction)
+			r.Get("/", clientCntrl.GetTransaction, router.RequireTeam(clientCntrl.TransactionTeams))
+		})
+
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/transfer"), func(r router.Router) {
+		r.Post("/", clientCntrl.CreateTransfer)
+		r.Get("/window", clientCntrl.GetTransferWindow)
+
+		r.Route(fmt.Sprintf("/{transferId:%s}", id.IDPrefixTransfer.REMatch()), func(r router.Router) {
+			r.Get("/", clientCntrl.GetTransfer, router.RequireTeam(clientCntrl.TransferTeams))
+		})
+
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/offer"), func(r router.Router) {
+		r.Post("/", clientCntrl.CreateOffer)
+
+		r.Route(fmt.Sprintf("/{offerId:%s}", id.IDPrefixOffer.REMatch()), func(r router.Router) {
+			r.Get("/", clientCntrl.GetOffer, router.RequireTeam(clientCntrl.OfferTeams))
+			r.Post("/respond", clientCntrl.RespondOffer)
+		})
+
+	})
+
+	r.Route(clientCntrl.GetAPIVersionPath("/loan"), func(r router.Router) {
+		r.Post("/", clientCntrl.CreateLoan)
+
+		r.Route(fmt.Sprintf("/{loanId:%s}", id.IDPrefixLoan.REMatch()), func(r router.Router) {
+			r.Get("/", clientCntrl.GetLoan, router.RequireTeam(clientCntrl.LoanTeams))
 		})
 
 	})
@@ -30,6 +30,8 @@ func initialise() {
 	initCollections()
 	initGRPCServices()
 	initGRPCServer()
+	initSchedulers()
+	checkTransfers()
 }
 
 func run() {
@@ -51,6 +53,11 @@ func main() {
 }
 
 func cleanUp() {
+	auctionScheduler.Stop()
+	listingScheduler.Stop()
+	loanScheduler.Stop()
+	payrollScheduler.Stop()
+	offerScheduler.Stop()
 	asyncWg.Wait()
 	mongoClient.Disconnect(context.Background())
 }
@@ -2,12 +2,22 @@ package main
 
 import (
 	"context"
+	"log"
+	grpcLoan "protobuf-v1/golang/loan"
 	grpcLogin "protobuf-v1/golang/login"
+	grpcOffer "protobuf-v1/golang/offer"
 	grpcPlayer "protobuf-v1/golang/player"
 	grpcTeam "protobuf-v1/golang/team"
 	grpcTransaction "protobuf-v1/golang/transaction"
+	grpcTransfer "protobuf-v1/golang/transfer"
 	grpcUser "protobuf-v1/golang/user"
+	"soccer-manager/internal/db"
 	"soccer-manager/internal/service"
+	"soccer-manager/util/config"
+	"soccer-manager/util/jwt"
+	"soccer-manager/util/logging"
+	"soccer-manager/util/mailer"
+	"soccer-manager/util/names"
 	"time"
 
 	ggrpc "google.golang.org/grpc"
@@ -18,41 +28,221 @@ import (
 )
 
 var (
-	mongoDatabase         *mongo.Database
-	userCollection        *mongo.Collection
-	teamCollection        *mongo.Collection
-	playerCollection      *mongo.Collection
-	transactionCollection *mongo.Collection
-	server                *ggrpc.Server
-	loginServer           grpcLogin.LoginServiceServer
-	userServer            grpcUser.UserServiceServer
-	playerServer          grpcPlayer.PlayerServiceServer
-	teamServer            grpcTeam.TeamServiceServer
-	transactionService    grpcTransaction.TransactionServiceServer
+	mongoDatabase          *mongo.Database
+	collections            service.Collections
+	loginAttemptCollection *mongo.Collection
+	loginAuditCollection   *mongo.Collection
+	server                 *ggrpc.Server
+	loginServer            grpcLogin.LoginServiceServer
+	userServer             grpcUser.UserServiceServer
+	playerServer           grpcPlayer.PlayerServiceServer
+	teamServer             grpcTeam.TeamServiceServer
+	transactionService     grpcTransaction.TransactionServiceServer
+	transferServer         grpcTransfer.TransferServiceServer
+	offerServer            grpcOffer.OfferServiceServer
+	loanServer             grpcLoan.LoanServiceServer
+	auctionScheduler       service.Scheduler
+	playerValuation        service.PlayerValuation
+	transferCalendar       service.TransferCalendar
+	nameGenerator          *names.Generator
+	keySet                 *jwt.KeySet
+	mailSender             mailer.Mailer
+	loginAttempts          db.LoginAttemptStore
+	listingScheduler       service.Scheduler
+	loanScheduler          service.Scheduler
+	payrollScheduler       service.Scheduler
+	offerScheduler         service.Scheduler
 )
 
 func initCollections() {
 	mongoDatabase = mongoClient.Database("soccer")
 
-	userCollection = mongoDatabase.Collection("users")
+	collections.User = mongoDatabase.Collection("users")
 	mongoDatabase.RunCommand(context.TODO(), bson.D{{"create", "users"}})
+	//the unique email index is what keeps two users from registering the same email
+	if err := db.CreateUserIndexes(context.TODO(), collections.User); err != nil {
+		log.Fatalf("Error creating user indexes, err=%s", err.Error())
+	}
 
-	playerCollection = mongoDatabase.Collection("players")
+	collections.Player = mongoDatabase.Collection("players")
 	mongoDatabase.RunCommand(context.TODO(), bson.D{{"create", "players"}})
+	if err := db.CreatePlayerIndexes(context.TODO(), collections.Player); err != nil {
+		logging.Error("failed to create player indexes", logging.Fields{"error": err.Error()})
+	}
 
-	teamCollection = mongoDatabase.Collection("teams")
+	collections.Team = mongoDatabase.Collection("teams")
 	mongoDatabase.RunCommand(context.TODO(), bson.D{{"create", "teams"}})
 
-	transactionCollection = mongoDatabase.Collection("transactions")
+	collections.Transaction = mongoDatabase.Collection("transactions")
 	mongoDatabase.RunCommand(context.TODO(), bson.D{{"create", "transactions"}})
+	if err := db.CreateTransactionIndexes(context.TODO(), collections.Transaction); err != nil {
+		logging.Error("failed to create transaction indexes", logging.Fields{"error": err.Error()})
+	}
+
+	collections.Transfer = mongoDatabase.Collection("transfers")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "transfers"}})
+
+	collections.Bid = mongoDatabase.Collection("bids")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "bids"}})
+
+	collections.Offer = mongoDatabase.Collection("offers")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "offers"}})
+
+	collections.Ledger = mongoDatabase.Collection("ledger")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "ledger"}})
+
+	collections.History = mongoDatabase.Collection("playerHistory")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "playerHistory"}})
+	if err := db.CreatePlayerHistoryIndexes(context.TODO(), collections.History); err != nil {
+		logging.Error("failed to create player history indexes", logging.Fields{"error": err.Error()})
+	}
+
+	collections.Loan = mongoDatabase.Collection("loans")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "loans"}})
+
+	collections.RefreshToken = mongoDatabase.Collection("refreshTokens")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "refreshTokens"}})
+	if err := db.CreateRefreshTokenIndexes(context.TODO(), collections.RefreshToken); err != nil {
+		logging.Error("failed to create refresh token indexes", logging.Fields{"error": err.Error()})
+	}
+
+	collections.RevokedSession = mongoDatabase.Collection("revokedSessions")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "revokedSessions"}})
+	if err := db.CreateRevokedSessionIndexes(context.TODO(), collections.RevokedSession); err != nil {
+		logging.Error("failed to create revoked session indexes", logging.Fields{"error": err.Error()})
+	}
+
+	collections.UserToken = mongoDatabase.Collection("userTokens")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "userTokens"}})
+	if err := db.CreateUserTokenIndexes(context.TODO(), collections.UserToken); err != nil {
+		logging.Error("failed to create user token indexes", logging.Fields{"error": err.Error()})
+	}
+
+	collections.ApiKey = mongoDatabase.Collection("apiKeys")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "apiKeys"}})
+	if err := db.CreateApiKeyIndexes(context.TODO(), collections.ApiKey); err != nil {
+		logging.Error("failed to create api key indexes", logging.Fields{"error": err.Error()})
+	}
+
+	collections.Migration = mongoDatabase.Collection("migrations")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "migrations"}})
+
+	loginAttemptCollection = mongoDatabase.Collection("loginAttempts")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "loginAttempts"}})
+	if err := db.CreateLoginAttemptIndexes(context.TODO(), loginAttemptCollection); err != nil {
+		logging.Error("failed to create login attempt indexes", logging.Fields{"error": err.Error()})
+	}
+
+	loginAuditCollection = mongoDatabase.Collection("loginAudits")
+	mongoDatabase.RunCommand(context.TODO(), bson.D{bson.E{Key: "create", Value: "loginAudits"}})
+	if err := db.CreateLoginAuditIndexes(context.TODO(), loginAuditCollection); err != nil {
+		logging.Error("failed to create login audit indexes", logging.Fields{"error": err.Error()})
+	}
 }
 
 func initGRPCServices() {
-	loginServer = service.NewLoginService(userCollection, playerCollection, teamCollection, mongoClient, asyncWg)
-	userServer = service.NewUserService(userCollection)
-	playerServer = service.NewPlayerService(playerCollection)
-	teamServer = service.NewTeamService(teamCollection)
-	transactionService = service.NewTransactionService(transactionCollection, playerCollection, teamCollection, mongoClient)
+	var err error
+	playerValuation, err = service.NewPlayerValuation(collections.History)
+	if err != nil {
+		log.Fatal(err)
+	}
+	transferCalendar, err = service.NewTransferCalendar()
+	if err != nil {
+		log.Fatal(err)
+	}
+	//names.seed makes generated names repeatable, without it every start draws different names
+	nameSeed := config.GetInt64("names.seed")
+	if nameSeed == 0 {
+		nameSeed = time.Now().UnixNano()
+	}
+	nameGenerator = names.NewGenerator(nameSeed)
+
+	//jwt.keys lists every key tokens are verified with, jwt.signingKeyId the one new tokens are signed with
+	var keyConfigs []jwt.KeyConfig
+	if err := config.UnmarshalKey("jwt.keys", &keyConfigs); err != nil {
+		log.Fatal(err)
+	}
+	keySet, err = jwt.LoadKeySet(config.GetString("jwt.signingKeyId"), keyConfigs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//mail.driver picks the sender, stdout prints the mails for local use
+	mailSender, err = mailer.New(mailer.Config{
+		Driver: config.GetString("mail.driver"),
+		From:   config.GetString("mail.from"),
+		File:   config.GetString("mail.file"),
+		SMTP: mailer.SMTPConfig{
+			Host:     config.GetString("mail.smtp.host"),
+			Port:     config.GetInt("mail.smtp.port"),
+			Username: config.GetString("mail.smtp.username"),
+			Password: config.GetString("mail.smtp.password"),
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//loginThrottle.store keeps failed login counters in mongo, memory only suits a single internal service
+	loginAttempts, err = service.NewLoginAttemptStore(loginAttemptCollection, loginAuditCollection)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	loginServer = service.NewLoginService(collections, loginAttempts, nameGenerator, keySet, mailSender, asyncWg, mongoClient)
+	userServer = service.NewUserService(collections.User)
+	playerServer = service.NewPlayerService(collections, transferCalendar, mongoClient)
+	teamServer = service.NewTeamService(collections, mongoClient)
+	transactionService = service.NewTransactionService(collections, playerValuation, transferCalendar, mongoClient)
+	transferServer = service.NewTransferService(collections.Transfer, transactionService, transferCalendar)
+	offerServer = service.NewOfferService(collections, playerValuation, transferCalendar, mongoClient)
+	loanServer = service.NewLoanService(collections, transferCalendar, mongoClient)
+}
+
+func initSchedulers() {
+	auctionSettler := service.NewAuctionSettler(collections, playerValuation, mongoClient)
+	auctionScheduler = service.NewScheduler("auction-settlement", time.Duration(config.GetInt("auction.settleIntervalSeconds"))*time.Second, auctionSettler.SettleExpired, asyncWg)
+	auctionScheduler.Start()
+
+	listingExpirer := service.NewListingExpirer(collections, transferCalendar)
+	listingScheduler = service.NewScheduler("listing-expiry", time.Duration(config.GetInt("transferWindow.checkIntervalSeconds"))*time.Second, listingExpirer.UnlistOutsideWindow, asyncWg)
+	if config.GetBool("transferWindow.unlistOnClose") {
+		listingScheduler.Start()
+	}
+
+	loanReturner := service.NewLoanReturner(collections, mongoClient)
+	loanScheduler = service.NewScheduler("loan-return", time.Duration(config.GetInt("loan.returnIntervalSeconds"))*time.Second, loanReturner.ReturnExpired, asyncWg)
+	loanScheduler.Start()
+
+	payroll := service.NewPayroll(collections, mongoClient)
+	payrollScheduler = service.NewScheduler("payroll", time.Duration(config.GetInt("payroll.checkIntervalSeconds"))*time.Second, payroll.PayWages, asyncWg)
+	payrollScheduler.Start()
+
+	offerExpirer := service.NewOfferExpirer(collections, mongoClient)
+	offerScheduler = service.NewScheduler("offer-expiry", time.Duration(config.GetInt("offer.expireIntervalSeconds"))*time.Second, offerExpirer.ExpireStale, asyncWg)
+	offerScheduler.Start()
+}
+
+// checkTransfers reports transfers with missing transactions or ledger entries
+func checkTransfers() {
+	asyncWg.Add(1)
+	go func() {
+		defer asyncWg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.GetInt64("backfill.checkTimeoutSeconds"))*time.Second)
+		defer cancel()
+
+		resp, err := transactionService.BackfillTransfers(ctx, &grpcTransaction.BackfillTransfersRequest{DryRun: true, Force: true})
+		if err != nil {
+			logging.Error("failed to check transfers", logging.Fields{"error": err.Error()})
+			return
+		}
+		for _, gap := range resp.Gaps {
+			logging.Warn("transfer gap", logging.Fields{"playerId": gap.PlayerId, "fromTeamId": gap.FromTeamId, "toTeamId": gap.ToTeamId, "reason": gap.Reason})
+		}
+		if resp.Transactions > 0 || resp.LedgerEntries > 0 || len(resp.Gaps) > 0 {
+			logging.Warn("transfer records are missing, run cmd/backfill", logging.Fields{"transactions": resp.Transactions, "ledgerEntries": resp.LedgerEntries, "gaps": len(resp.Gaps)})
+		}
+	}()
 }
 
 func initGRPCServer() {
@@ -70,4 +260,7 @@ func registerGRPCServerServices() {
 	grpcPlayer.RegisterPlayerServiceServer(server, playerServer)
 	grpcTeam.RegisterTeamServiceServer(server, teamServer)
 	grpcTransaction.RegisterTransactionServiceServer(server, transactionService)
+	grpcTransfer.RegisterTransferServiceServer(server, transferServer)
+	grpcOffer.RegisterOfferServiceServer(server, offerServer)
+	grpcLoan.RegisterLoanServiceServer(server, loanServer)
 }
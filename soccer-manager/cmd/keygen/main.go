@@ -0,0 +1,62 @@
+package main
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+// keygen writes a token signing key for jwt.keys to -out and, with -pub, its public key
+func main() {
+	alg := flag.String("alg", "EdDSA", "key algorithm, EdDSA or RS256")
+	out := flag.String("out", "", "file the private key is written to")
+	pub := flag.String("pub", "", "file the public key is written to, skipped when empty")
+	bits := flag.Int("bits", 2048, "RSA key size")
+	flag.Parse()
+
+	if *out == "" {
+		log.Fatal("-out is required")
+	}
+
+	var privateKey crypto.Signer
+	var err error
+	switch *alg {
+	case "EdDSA":
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	case "RS256":
+		privateKey, err = rsa.GenerateKey(rand.Reader, *bits)
+	default:
+		log.Fatalf("unsupported algorithm %q", *alg)
+	}
+	if err != nil {
+		log.Fatalf("Key generation failed, err=%s", err.Error())
+	}
+
+	privateDer, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		log.Fatalf("Key encoding failed, err=%s", err.Error())
+	}
+	if err := ioutil.WriteFile(*out, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDer}), 0600); err != nil {
+		log.Fatalf("Writing %s failed, err=%s", *out, err.Error())
+	}
+	fmt.Printf("private key written to %s\n", *out)
+
+	if *pub == "" {
+		return
+	}
+	publicDer, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		log.Fatalf("Key encoding failed, err=%s", err.Error())
+	}
+	if err := ioutil.WriteFile(*pub, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDer}), 0644); err != nil {
+		log.Fatalf("Writing %s failed, err=%s", *pub, err.Error())
+	}
+	fmt.Printf("public key written to %s\n", *pub)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/util"
+	"time"
+
+	ggrpc "google.golang.org/grpc"
+)
+
+// reconcile reports budget and value drift of one or all teams, -repair corrects it
+func main() {
+	addr := flag.String("addr", "localhost:3001", "address of the internal grpc service")
+	teamId := flag.String("team", "", "team id to reconcile, all teams when empty")
+	repair := flag.Bool("repair", false, "correct drifted budgets and values")
+	timeout := flag.Duration("timeout", 5*time.Minute, "time allowed for the reconciliation")
+	flag.Parse()
+
+	serviceConn, err := ggrpc.Dial(*addr, ggrpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Error initializing grpc service client, err=%s", err.Error())
+	}
+	defer serviceConn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := grpcTxn.NewTransactionServiceClient(serviceConn).Reconcile(ctx, &grpcTxn.ReconcileRequest{TeamId: *teamId, Repair: *repair})
+	if err != nil {
+		log.Fatalf("Reconciliation failed, err=%s", err.Error())
+	}
+
+	for _, team := range resp.Teams {
+		if !team.BudgetDrift && !team.ValueDrift {
+			continue
+		}
+		fmt.Printf("%s budget stored=%s ledger=%s value stored=%s players=%s missingOpeningBalance=%t repaired=%t\n",
+			team.TeamId,
+			util.ParseAmountToString(team.StoredBudget),
+			util.ParseAmountToString(team.LedgerBudget),
+			util.ParseAmountToString(team.StoredValue),
+			util.ParseAmountToString(team.PlayerValue),
+			team.MissingOpeningBalance,
+			team.Repaired,
+		)
+	}
+	fmt.Printf("%d teams checked, %d drifted\n", resp.Total, resp.Drifted)
+
+	if resp.Drifted > 0 && !*repair {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	grpcLogin "protobuf-v1/golang/login"
+	"time"
+
+	ggrpc "google.golang.org/grpc"
+)
+
+// repair reports users without their team or full squad, -repair creates the missing ones
+func main() {
+	addr := flag.String("addr", "localhost:3001", "address of the internal grpc service")
+	userId := flag.String("user", "", "user id to check, all users when empty")
+	repair := flag.Bool("repair", false, "create missing teams and players")
+	timeout := flag.Duration("timeout", 5*time.Minute, "time allowed for the check")
+	flag.Parse()
+
+	serviceConn, err := ggrpc.Dial(*addr, ggrpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Error initializing grpc service client, err=%s", err.Error())
+	}
+	defer serviceConn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := grpcLogin.NewLoginServiceClient(serviceConn).RepairTeams(ctx, &grpcLogin.RepairTeamsRequest{UserId: *userId, Repair: *repair})
+	if err != nil {
+		log.Fatalf("Squad check failed, err=%s", err.Error())
+	}
+
+	for _, user := range resp.Users {
+		if user.Ambiguous {
+			fmt.Printf("%s team=%s ambiguous: %s\n", user.UserId, user.TeamId, user.Reason)
+			continue
+		}
+		if !user.MissingTeam && user.MissingPlayers == 0 {
+			continue
+		}
+		fmt.Printf("%s team=%s missingTeam=%t missingPlayers=%d repaired=%t\n",
+			user.UserId,
+			user.TeamId,
+			user.MissingTeam,
+			user.MissingPlayers,
+			user.Repaired,
+		)
+	}
+	fmt.Printf("%d users checked, %d incomplete, %d ambiguous\n", resp.Total, resp.Incomplete, resp.Ambiguous)
+
+	if (resp.Incomplete > 0 && !*repair) || resp.Ambiguous > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	grpcLogin "protobuf-v1/golang/login"
+	"soccer-manager/util"
+	"time"
+
+	ggrpc "google.golang.org/grpc"
+)
+
+// role sets the role of a user against the internal grpc service, the first admin is made with it
+func main() {
+	addr := flag.String("addr", "localhost:3001", "address of the internal grpc service")
+	userId := flag.String("user", "", "user id to change")
+	role := flag.String("role", string(util.RoleAdmin), "new role, one of manager, support or admin")
+	timeout := flag.Duration("timeout", 30*time.Second, "time allowed for the change")
+	flag.Parse()
+
+	grpcRole, ok := util.RoleToProto[util.Role(*role)]
+	if *userId == "" || !ok {
+		flag.Usage()
+		log.Fatalf("A user id and one of the roles manager, support or admin are required")
+	}
+
+	serviceConn, err := ggrpc.Dial(*addr, ggrpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Error initializing grpc service client, err=%s", err.Error())
+	}
+	defer serviceConn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	user, err := grpcLogin.NewLoginServiceClient(serviceConn).SetRole(ctx, &grpcLogin.SetRoleRequest{UserId: *userId, Role: grpcRole})
+	if err != nil {
+		log.Fatalf("Role change failed, err=%s", err.Error())
+	}
+	fmt.Printf("%s email=%s role=%s, sessions revoked\n", user.Id, user.Email, util.RoleFromProto[user.Role])
+}
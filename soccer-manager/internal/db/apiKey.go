@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/id"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateApiKeyIndexes backs listing the keys of a user
+func CreateApiKeyIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "userId", Value: 1}, {Key: "createdAt", Value: 1}},
+	})
+	return err
+}
+
+type ApiKeyDbManager interface {
+	Create(context.Context, *model.ApiKey) (*model.ApiKey, error)
+	Get(context.Context, id.ApiKeyID) (*model.ApiKey, error)
+	Find(context.Context, map[string]interface{}) ([]*model.ApiKey, error)
+	Count(context.Context, map[string]interface{}) (int64, error)
+	Update(context.Context, *model.ApiKey, ...map[string]interface{}) (*model.ApiKey, error)
+	RevokeUser(context.Context, id.UserID, time.Time) (int64, error)
+}
+
+type apiKey struct {
+	collection *mongo.Collection
+}
+
+func NewApiKeyDbManager(collection *mongo.Collection) ApiKeyDbManager {
+	return apiKey{
+		collection: collection,
+	}
+}
+
+func (a apiKey) Create(ctx context.Context, am *model.ApiKey) (*model.ApiKey, error) {
+	am.CreatedAt = time.Now()
+	am.UpdatedAt = am.CreatedAt
+
+	_, err := a.collection.InsertOne(ctx, am)
+	return am, err
+}
+
+func (a apiKey) Get(ctx context.Context, keyID id.ApiKeyID) (*model.ApiKey, error) {
+	filter := bson.D{{
+		Key:   "_id",
+		Value: keyID,
+	}}
+	key := &model.ApiKey{}
+	if err := a.collection.FindOne(ctx, filter).Decode(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Find returns the keys oldest first
+func (a apiKey) Find(ctx context.Context, filters map[string]interface{}) ([]*model.ApiKey, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	cur, err := a.collection.Find(ctx, dbFilters, opts)
+	if err != nil {
+		return nil, err
+	}
+	var keys []*model.ApiKey
+	for cur.Next(ctx) {
+		key := &model.ApiKey{}
+		if err := cur.Decode(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	// once exhausted, close the cursor
+	cur.Close(ctx)
+	return keys, nil
+}
+
+func (a apiKey) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	return a.collection.CountDocuments(ctx, dbFilters)
+}
+
+func (a apiKey) Update(ctx context.Context, updateModel *model.ApiKey, filters ...map[string]interface{}) (*model.ApiKey, error) {
+	filter := bson.M{"_id": updateModel.Id}
+	if len(filters) > 0 {
+		for key, val := range filters[0] {
+			filter[key] = val
+		}
+	}
+
+	update := bson.M{"$set": a.getUpdateMap(updateModel)}
+	key := &model.ApiKey{}
+	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
+	if err := a.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// RevokeUser revokes every key of the user that is not revoked yet and returns how many were
+func (a apiKey) RevokeUser(ctx context.Context, userID id.UserID, revokedAt time.Time) (int64, error) {
+	filter := bson.M{"userId": userID, "revokedAt": nil}
+	update := bson.M{"$set": bson.M{"revokedAt": revokedAt, "updatedAt": time.Now()}}
+	result, err := a.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+func (a apiKey) getUpdateMap(updateModel *model.ApiKey) bson.M {
+	updateMap := bson.M{"updatedAt": time.Now()}
+	if updateModel.LastUsedAt != nil {
+		updateMap["lastUsedAt"] = updateModel.LastUsedAt
+	}
+	if updateModel.RevokedAt != nil {
+		updateMap["revokedAt"] = updateModel.RevokedAt
+	}
+	return updateMap
+}
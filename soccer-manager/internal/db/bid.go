@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	grpcPlayer "protobuf-v1/golang/player"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/id"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type BidDbManager interface {
+	Create(context.Context, *model.Bid) (*model.Bid, error)
+	Get(context.Context, id.BidID) (*model.Bid, error)
+	Find(context.Context, map[string]interface{}) ([]*model.Bid, error)
+	Update(context.Context, *model.Bid, ...map[string]interface{}) (*model.Bid, error)
+}
+
+type bid struct {
+	collection *mongo.Collection
+}
+
+func NewBidDbManager(collection *mongo.Collection) BidDbManager {
+	return bid{
+		collection: collection,
+	}
+}
+
+func (b bid) Create(ctx context.Context, bm *model.Bid) (*model.Bid, error) {
+	bm.CreatedAt = time.Now()
+	bm.UpdatedAt = bm.CreatedAt
+
+	_, err := b.collection.InsertOne(ctx, bm)
+	return bm, err
+}
+
+func (b bid) Get(ctx context.Context, bidID id.BidID) (*model.Bid, error) {
+	filter := bson.D{{
+		Key:   "_id",
+		Value: bidID,
+	}}
+	bid := &model.Bid{}
+	if err := b.collection.FindOne(ctx, filter).Decode(bid); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+func (b bid) Update(ctx context.Context, updateModel *model.Bid, filters ...map[string]interface{}) (*model.Bid, error) {
+	filter := bson.M{"_id": updateModel.Id}
+	if len(filters) > 0 {
+		for key, val := range filters[0] {
+			filter[key] = val
+		}
+	}
+
+	update := bson.M{"$set": b.getUpdateMap(updateModel)}
+	bid := &model.Bid{}
+	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
+	if err := b.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(bid); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+func (b bid) Find(ctx context.Context, filters map[string]interface{}) ([]*model.Bid, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	cur, err := b.collection.Find(ctx, dbFilters)
+	if err != nil {
+		return nil, err
+	}
+	var bids []*model.Bid
+	for cur.Next(ctx) {
+		bid := &model.Bid{}
+		if err := cur.Decode(&bid); err != nil {
+			return nil, err
+		}
+		bids = append(bids, bid)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	// once exhausted, close the cursor
+	cur.Close(ctx)
+	return bids, nil
+}
+
+func (b bid) getUpdateMap(updateModel *model.Bid) bson.M {
+	updateMap := bson.M{"updatedAt": time.Now()}
+	if !(updateModel.Amount == 0) {
+		updateMap["amount"] = updateModel.Amount
+	}
+	if !(updateModel.Status == grpcPlayer.BidStatus_BS_UNSPECIFIED) {
+		updateMap["status"] = updateModel.Status
+	}
+	return updateMap
+}
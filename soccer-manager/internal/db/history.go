@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PlayerHistoryDbManager interface {
+	Create(context.Context, *model.PlayerHistoryEntry) (*model.PlayerHistoryEntry, error)
+	Find(context.Context, map[string]interface{}) ([]*model.PlayerHistoryEntry, error)
+}
+
+// CreatePlayerHistoryIndexes backs the history of a player, which is read oldest first
+func CreatePlayerHistoryIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "playerId", Value: 1}, {Key: "createdAt", Value: 1}},
+	})
+	return err
+}
+
+type playerHistory struct {
+	collection *mongo.Collection
+}
+
+func NewPlayerHistoryDbManager(collection *mongo.Collection) PlayerHistoryDbManager {
+	return playerHistory{
+		collection: collection,
+	}
+}
+
+func (p playerHistory) Create(ctx context.Context, hm *model.PlayerHistoryEntry) (*model.PlayerHistoryEntry, error) {
+	//entries rebuilt by a backfill keep the time of the record they are rebuilt from
+	if hm.CreatedAt.IsZero() {
+		hm.CreatedAt = time.Now()
+	}
+	_, err := p.collection.InsertOne(ctx, hm)
+	return hm, err
+}
+
+// Find returns the matching entries oldest first
+func (p playerHistory) Find(ctx context.Context, filters map[string]interface{}) ([]*model.PlayerHistoryEntry, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+	cur, err := p.collection.Find(ctx, dbFilters, opts)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*model.PlayerHistoryEntry
+	for cur.Next(ctx) {
+		entry := &model.PlayerHistoryEntry{}
+		if err := cur.Decode(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	// once exhausted, close the cursor
+	cur.Close(ctx)
+	return entries, nil
+}
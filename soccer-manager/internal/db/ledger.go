@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type LedgerDbManager interface {
+	Create(context.Context, *model.LedgerEntry) (*model.LedgerEntry, error)
+	Find(context.Context, map[string]interface{}) ([]*model.LedgerEntry, error)
+}
+
+type ledger struct {
+	collection *mongo.Collection
+}
+
+func NewLedgerDbManager(collection *mongo.Collection) LedgerDbManager {
+	return ledger{
+		collection: collection,
+	}
+}
+
+func (l ledger) Create(ctx context.Context, lm *model.LedgerEntry) (*model.LedgerEntry, error) {
+	//records rebuilt by a backfill keep the time of the record they are rebuilt from
+	if lm.CreatedAt.IsZero() {
+		lm.CreatedAt = time.Now()
+	}
+	_, err := l.collection.InsertOne(ctx, lm)
+	return lm, err
+}
+
+func (l ledger) Find(ctx context.Context, filters map[string]interface{}) ([]*model.LedgerEntry, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	cur, err := l.collection.Find(ctx, dbFilters)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*model.LedgerEntry
+	for cur.Next(ctx) {
+		entry := &model.LedgerEntry{}
+		if err := cur.Decode(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	// once exhausted, close the cursor
+	cur.Close(ctx)
+	return entries, nil
+}
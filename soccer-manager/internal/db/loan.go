@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	grpcLoan "protobuf-v1/golang/loan"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/id"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type LoanDbManager interface {
+	Create(context.Context, *model.Loan) (*model.Loan, error)
+	Get(context.Context, id.LoanID) (*model.Loan, error)
+	Find(context.Context, map[string]interface{}) ([]*model.Loan, error)
+	Update(context.Context, *model.Loan, ...map[string]interface{}) (*model.Loan, error)
+}
+
+type loan struct {
+	collection *mongo.Collection
+}
+
+func NewLoanDbManager(collection *mongo.Collection) LoanDbManager {
+	return loan{
+		collection: collection,
+	}
+}
+
+func (l loan) Create(ctx context.Context, lm *model.Loan) (*model.Loan, error) {
+	lm.CreatedAt = time.Now()
+	lm.UpdatedAt = lm.CreatedAt
+
+	_, err := l.collection.InsertOne(ctx, lm)
+	return lm, err
+}
+
+func (l loan) Get(ctx context.Context, loanID id.LoanID) (*model.Loan, error) {
+	filter := bson.D{{
+		Key:   "_id",
+		Value: loanID,
+	}}
+	loan := &model.Loan{}
+	if err := l.collection.FindOne(ctx, filter).Decode(loan); err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+func (l loan) Update(ctx context.Context, updateModel *model.Loan, filters ...map[string]interface{}) (*model.Loan, error) {
+	filter := bson.M{"_id": updateModel.Id}
+	if len(filters) > 0 {
+		for key, val := range filters[0] {
+			filter[key] = val
+		}
+	}
+
+	update := bson.M{"$set": l.getUpdateMap(updateModel)}
+	loan := &model.Loan{}
+	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
+	if err := l.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(loan); err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+func (l loan) Find(ctx context.Context, filters map[string]interface{}) ([]*model.Loan, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	cur, err := l.collection.Find(ctx, dbFilters)
+	if err != nil {
+		return nil, err
+	}
+	var loans []*model.Loan
+	for cur.Next(ctx) {
+		loan := &model.Loan{}
+		if err := cur.Decode(&loan); err != nil {
+			return nil, err
+		}
+		loans = append(loans, loan)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	// once exhausted, close the cursor
+	cur.Close(ctx)
+	return loans, nil
+}
+
+func (l loan) getUpdateMap(updateModel *model.Loan) bson.M {
+	updateMap := bson.M{"updatedAt": time.Now()}
+	if !(updateModel.Status == grpcLoan.LoanStatus_LS_UNSPECIFIED) {
+		updateMap["status"] = updateModel.Status
+	}
+	if updateModel.ReturnedAt != nil {
+		updateMap["returnedAt"] = updateModel.ReturnedAt
+	}
+	return updateMap
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateLoginAttemptIndexes drops failed login counters once no login failed for a while
+func CreateLoginAttemptIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return err
+}
+
+// CreateLoginAuditIndexes backs looking up the failed logins of an email or ip, records are kept until ExpiresAt
+func CreateLoginAuditIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "email", Value: 1}, {Key: "createdAt", Value: -1}}},
+		{Keys: bson.D{{Key: "ip", Value: 1}, {Key: "createdAt", Value: -1}}},
+		{Keys: bson.D{{Key: "expiresAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	return err
+}
+
+// LoginAttemptStore keeps the failed login counters and audit records
+type LoginAttemptStore interface {
+	// Get returns the counter of the key, nil when there is none or it has expired
+	Get(context.Context, string) (*model.LoginAttempt, error)
+	// Reserve counts a failed login attempt and returns the counter as it was before, nil when there was none
+	Reserve(context.Context, string, time.Time, time.Time) (*model.LoginAttempt, error)
+	// Release takes back the attempt reserved at the time, given the counter Reserve returned
+	Release(context.Context, string, time.Time, *model.LoginAttempt) error
+	Reset(context.Context, string) error
+	Audit(context.Context, *model.LoginAudit) error
+}
+
+type loginAttempt struct {
+	attemptCollection *mongo.Collection
+	auditCollection   *mongo.Collection
+}
+
+func NewLoginAttemptDbManager(attemptCollection *mongo.Collection, auditCollection *mongo.Collection) LoginAttemptStore {
+	return loginAttempt{
+		attemptCollection: attemptCollection,
+		auditCollection:   auditCollection,
+	}
+}
+
+func (l loginAttempt) Get(ctx context.Context, key string) (*model.LoginAttempt, error) {
+	filter := bson.M{"_id": key, "expiresAt": bson.M{"$gt": time.Now()}}
+	attempt := &model.LoginAttempt{}
+	err := l.attemptCollection.FindOne(ctx, filter).Decode(attempt)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// Reserve increments the counter in place, an expired counter is started over
+func (l loginAttempt) Reserve(ctx context.Context, key string, at time.Time, expiresAt time.Time) (*model.LoginAttempt, error) {
+	if _, err := l.attemptCollection.DeleteOne(ctx, bson.M{"_id": key, "expiresAt": bson.M{"$lte": at}}); err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": key}
+	update := bson.M{
+		"$inc": bson.M{"failures": 1},
+		"$max": bson.M{"lastFailureAt": at, "expiresAt": expiresAt},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+	attempt := &model.LoginAttempt{}
+	err := l.attemptCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(attempt)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// Release decrements the counter and drops it when the attempt started it and nothing else was counted
+func (l loginAttempt) Release(ctx context.Context, key string, at time.Time, previous *model.LoginAttempt) error {
+	filter := bson.M{"_id": key, "failures": bson.M{"$gt": 0}}
+	if _, err := l.attemptCollection.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"failures": -1}}); err != nil {
+		return err
+	}
+
+	if previous == nil {
+		_, err := l.attemptCollection.DeleteOne(ctx, bson.M{"_id": key, "failures": bson.M{"$lte": 0}, "lastFailureAt": at})
+		return err
+	}
+	filter = bson.M{"_id": key, "lastFailureAt": at}
+	_, err := l.attemptCollection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"lastFailureAt": previous.LastFailureAt}})
+	return err
+}
+
+func (l loginAttempt) Reset(ctx context.Context, key string) error {
+	_, err := l.attemptCollection.DeleteOne(ctx, bson.M{"_id": key})
+	return err
+}
+
+func (l loginAttempt) Audit(ctx context.Context, am *model.LoginAudit) error {
+	am.CreatedAt = time.Now()
+	_, err := l.auditCollection.InsertOne(ctx, am)
+	return err
+}
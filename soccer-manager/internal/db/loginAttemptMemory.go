@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"sync"
+	"time"
+)
+
+type loginAttemptMemory struct {
+	mu        sync.Mutex
+	attempts  map[string]*model.LoginAttempt
+	audits    []*model.LoginAudit
+	auditSize int
+	sweptAt   time.Time
+}
+
+const loginAttemptSweepInterval = time.Minute
+
+// NewLoginAttemptMemoryStore keeps the counters and the last auditSize audit records in the process
+func NewLoginAttemptMemoryStore(auditSize int) LoginAttemptStore {
+	return &loginAttemptMemory{
+		attempts:  map[string]*model.LoginAttempt{},
+		auditSize: auditSize,
+	}
+}
+
+func (l *loginAttemptMemory) Get(ctx context.Context, key string) (*model.LoginAttempt, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	attempt := l.current(key, time.Now())
+	if attempt == nil {
+		return nil, nil
+	}
+	copied := *attempt
+	return &copied, nil
+}
+
+func (l *loginAttemptMemory) Reserve(ctx context.Context, key string, at time.Time, expiresAt time.Time) (*model.LoginAttempt, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var previous *model.LoginAttempt
+	attempt := l.current(key, at)
+	if attempt == nil {
+		attempt = &model.LoginAttempt{Key: key}
+		l.attempts[key] = attempt
+	} else {
+		copied := *attempt
+		previous = &copied
+	}
+	attempt.Failures++
+	if at.After(attempt.LastFailureAt) {
+		attempt.LastFailureAt = at
+	}
+	if expiresAt.After(attempt.ExpiresAt) {
+		attempt.ExpiresAt = expiresAt
+	}
+	l.dropExpired(at)
+
+	return previous, nil
+}
+
+func (l *loginAttemptMemory) Release(ctx context.Context, key string, at time.Time, previous *model.LoginAttempt) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	attempt := l.current(key, at)
+	if attempt == nil {
+		return nil
+	}
+	if attempt.Failures > 0 {
+		attempt.Failures--
+	}
+	if !attempt.LastFailureAt.Equal(at) {
+		return nil
+	}
+	if previous == nil {
+		if attempt.Failures <= 0 {
+			delete(l.attempts, key)
+		}
+		return nil
+	}
+	attempt.LastFailureAt = previous.LastFailureAt
+	return nil
+}
+
+func (l *loginAttemptMemory) Reset(ctx context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.attempts, key)
+	return nil
+}
+
+func (l *loginAttemptMemory) Audit(ctx context.Context, am *model.LoginAudit) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	am.CreatedAt = time.Now()
+	l.audits = append(l.audits, am)
+	if len(l.audits) > l.auditSize {
+		l.audits = l.audits[len(l.audits)-l.auditSize:]
+	}
+	return nil
+}
+
+// current returns the counter of the key unless it has expired, callers hold the lock
+func (l *loginAttemptMemory) current(key string, at time.Time) *model.LoginAttempt {
+	attempt, ok := l.attempts[key]
+	if !ok {
+		return nil
+	}
+	if !attempt.ExpiresAt.After(at) {
+		delete(l.attempts, key)
+		return nil
+	}
+	return attempt
+}
+
+// dropExpired keeps the map from growing with every ip that ever failed a login, callers hold the lock
+func (l *loginAttemptMemory) dropExpired(at time.Time) {
+	if at.Sub(l.sweptAt) < loginAttemptSweepInterval {
+		return
+	}
+	l.sweptAt = at
+	for key, attempt := range l.attempts {
+		if !attempt.ExpiresAt.After(at) {
+			delete(l.attempts, key)
+		}
+	}
+}
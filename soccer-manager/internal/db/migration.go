@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MigrationDbManager interface {
+	Get(context.Context, string) (*model.Migration, error)
+	MarkDone(context.Context, *model.Migration) (*model.Migration, error)
+}
+
+type migration struct {
+	collection *mongo.Collection
+}
+
+func NewMigrationDbManager(collection *mongo.Collection) MigrationDbManager {
+	return migration{
+		collection: collection,
+	}
+}
+
+func (m migration) Get(ctx context.Context, name string) (*model.Migration, error) {
+	filter := bson.D{{
+		Key:   "_id",
+		Value: name,
+	}}
+	migration := &model.Migration{}
+	if err := m.collection.FindOne(ctx, filter).Decode(migration); err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
+
+// MarkDone records the migration as done now, a forced run replaces the earlier record
+func (m migration) MarkDone(ctx context.Context, mm *model.Migration) (*model.Migration, error) {
+	mm.DoneAt = time.Now()
+	filter := bson.D{{
+		Key:   "_id",
+		Value: mm.Name,
+	}}
+	_, err := m.collection.ReplaceOne(ctx, filter, mm, options.Replace().SetUpsert(true))
+	return mm, err
+}
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	grpcOffer "protobuf-v1/golang/offer"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/id"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type OfferDbManager interface {
+	Create(context.Context, *model.Offer) (*model.Offer, error)
+	Get(context.Context, id.OfferID) (*model.Offer, error)
+	Find(context.Context, map[string]interface{}) ([]*model.Offer, error)
+	Update(context.Context, *model.Offer, ...map[string]interface{}) (*model.Offer, error)
+}
+
+type offer struct {
+	collection *mongo.Collection
+}
+
+func NewOfferDbManager(collection *mongo.Collection) OfferDbManager {
+	return offer{
+		collection: collection,
+	}
+}
+
+func (o offer) Create(ctx context.Context, om *model.Offer) (*model.Offer, error) {
+	om.CreatedAt = time.Now()
+	om.UpdatedAt = om.CreatedAt
+
+	_, err := o.collection.InsertOne(ctx, om)
+	return om, err
+}
+
+func (o offer) Get(ctx context.Context, offerID id.OfferID) (*model.Offer, error) {
+	filter := bson.D{{
+		Key:   "_id",
+		Value: offerID,
+	}}
+	offer := &model.Offer{}
+	if err := o.collection.FindOne(ctx, filter).Decode(offer); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+func (o offer) Update(ctx context.Context, updateModel *model.Offer, filters ...map[string]interface{}) (*model.Offer, error) {
+	filter := bson.M{"_id": updateModel.Id}
+	if len(filters) > 0 {
+		for key, val := range filters[0] {
+			filter[key] = val
+		}
+	}
+
+	update := bson.M{"$set": o.getUpdateMap(updateModel)}
+	offer := &model.Offer{}
+	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
+	if err := o.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(offer); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+func (o offer) Find(ctx context.Context, filters map[string]interface{}) ([]*model.Offer, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	cur, err := o.collection.Find(ctx, dbFilters)
+	if err != nil {
+		return nil, err
+	}
+	var offers []*model.Offer
+	for cur.Next(ctx) {
+		offer := &model.Offer{}
+		if err := cur.Decode(&offer); err != nil {
+			return nil, err
+		}
+		offers = append(offers, offer)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	// once exhausted, close the cursor
+	cur.Close(ctx)
+	return offers, nil
+}
+
+func (o offer) getUpdateMap(updateModel *model.Offer) bson.M {
+	updateMap := bson.M{"updatedAt": time.Now()}
+	if !(updateModel.Amount == 0) {
+		updateMap["amount"] = updateModel.Amount
+	}
+	if !(updateModel.HeldAmount == 0) {
+		updateMap["heldAmount"] = updateModel.HeldAmount
+	}
+	if !(updateModel.Status == grpcOffer.OfferStatus_OS_UNSPECIFIED) {
+		updateMap["status"] = updateModel.Status
+	}
+	if !updateModel.TransferId.IsZero() {
+		updateMap["transferId"] = updateModel.TransferId
+	}
+	if !updateModel.ExpiresAt.IsZero() {
+		updateMap["expiresAt"] = updateModel.ExpiresAt
+	}
+	return updateMap
+}
@@ -0,0 +1,111 @@
+package db
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Page describes one page of a keyset paginated query, sorted on SortField and then _id
+type Page struct {
+	Filters    map[string]interface{}
+	SortField  string
+	Descending bool
+	Limit      int64
+	Cursor     string
+}
+
+// pageCursor is the position of the last document of a page
+type pageCursor struct {
+	Value interface{} `bson:"v"`
+	Id    interface{} `bson:"id"`
+}
+
+func (p Page) limit() int64 {
+	if p.Limit <= 0 {
+		return DefaultPageSize
+	}
+	if p.Limit > MaxPageSize {
+		return MaxPageSize
+	}
+	return p.Limit
+}
+
+func (p Page) countFilter() bson.M {
+	dbFilters := bson.M{}
+	for key, val := range p.Filters {
+		dbFilters[key] = val
+	}
+	return dbFilters
+}
+
+// filter adds the keyset condition for the cursor to the page filters
+func (p Page) filter() (bson.M, error) {
+	dbFilters := p.countFilter()
+	if p.Cursor == "" {
+		return dbFilters, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(p.Cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	cursor := pageCursor{}
+	if err := bson.Unmarshal(raw, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	op := "$gt"
+	if p.Descending {
+		op = "$lt"
+	}
+	after := bson.A{
+		bson.M{p.SortField: cursor.Value, "_id": bson.M{op: cursor.Id}},
+	}
+	// null and missing values sort before every other value
+	switch {
+	case cursor.Value == nil && !p.Descending:
+		after = append(after, bson.M{p.SortField: bson.M{"$ne": nil}})
+	case cursor.Value != nil && p.Descending:
+		after = append(after, bson.M{p.SortField: bson.M{op: cursor.Value}}, bson.M{p.SortField: nil})
+	case cursor.Value != nil:
+		after = append(after, bson.M{p.SortField: bson.M{op: cursor.Value}})
+	}
+	return bson.M{"$and": bson.A{dbFilters, bson.M{"$or": after}}}, nil
+}
+
+// findOptions fetches one document more than the page size to know whether there is a next page
+func (p Page) findOptions() *options.FindOptions {
+	order := 1
+	if p.Descending {
+		order = -1
+	}
+	return options.Find().
+		SetSort(bson.D{{Key: p.SortField, Value: order}, {Key: "_id", Value: order}}).
+		SetLimit(p.limit() + 1)
+}
+
+// nextCursor returns the cursor pointing after the last document of the page
+func (p Page) nextCursor(last bson.Raw) (string, error) {
+	cursor := pageCursor{
+		Id: last.Lookup("_id"),
+	}
+	if value, err := last.LookupErr(p.SortField); err == nil && value.Type != bsontype.Null {
+		cursor.Value = value
+	}
+	raw, err := bson.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
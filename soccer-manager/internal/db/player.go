@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	grpcPlayer "protobuf-v1/golang/player"
 	"soccer-manager/internal/model"
 	"soccer-manager/util/id"
 	"time"
@@ -16,6 +17,20 @@ type PlayerDbManager interface {
 	Get(context.Context, id.PlayerID) (*model.Player, error)
 	Find(context.Context, map[string]interface{}) ([]*model.Player, error)
 	Update(context.Context, *model.Player, ...map[string]interface{}) (*model.Player, error)
+	FindPage(context.Context, Page) ([]*model.Player, string, error)
+	Count(context.Context, map[string]interface{}) (int64, error)
+}
+
+// CreatePlayerIndexes backs the transfer market search
+func CreatePlayerIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "isListed", Value: 1}, {Key: "askValue", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "isListed", Value: 1}, {Key: "value", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "isListed", Value: 1}, {Key: "age", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "isListed", Value: 1}, {Key: "skills.overall", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "teamId", Value: 1}}},
+	})
+	return err
 }
 
 type player struct {
@@ -48,19 +63,11 @@ func (p player) Get(ctx context.Context, txnID id.PlayerID) (*model.Player, erro
 }
 
 func (p player) Update(ctx context.Context, updateModel *model.Player, filters ...map[string]interface{}) (*model.Player, error) {
-	var filter interface{}
-
+	filter := bson.M{"_id": updateModel.Id}
 	if len(filters) > 0 {
-		filterMap := bson.M{}
 		for key, val := range filters[0] {
-			filterMap[key] = val
+			filter[key] = val
 		}
-		filter = filterMap
-	} else {
-		filter = bson.D{{
-			Key:   "_id",
-			Value: updateModel.Id,
-		}}
 	}
 
 	update := bson.M{"$set": p.getUpdateMap(updateModel)}
@@ -98,6 +105,48 @@ func (p player) Find(ctx context.Context, filters map[string]interface{}) ([]*mo
 	return players, nil
 }
 
+// FindPage returns one page of players and the cursor for the next page, which is empty on the last page
+func (p player) FindPage(ctx context.Context, page Page) ([]*model.Player, string, error) {
+	dbFilters, err := page.filter()
+	if err != nil {
+		return nil, "", err
+	}
+	cur, err := p.collection.Find(ctx, dbFilters, page.findOptions())
+	if err != nil {
+		return nil, "", err
+	}
+	defer cur.Close(ctx)
+
+	var players []*model.Player
+	var last bson.Raw
+	hasMore := false
+	for cur.Next(ctx) {
+		if int64(len(players)) == page.limit() {
+			hasMore = true
+			break
+		}
+		player := &model.Player{}
+		if err := cur.Decode(&player); err != nil {
+			return nil, "", err
+		}
+		players = append(players, player)
+		last = append(bson.Raw{}, cur.Current...)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if !hasMore {
+		return players, "", nil
+	}
+	nextCursor, err := page.nextCursor(last)
+	return players, nextCursor, err
+}
+
+func (p player) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	return p.collection.CountDocuments(ctx, Page{Filters: filters}.countFilter())
+}
+
 func (p player) getUpdateMap(updateModel *model.Player) bson.M {
 	updateMap := bson.M{}
 	if !(updateModel.FirstName == "") {
@@ -121,5 +170,35 @@ func (p player) getUpdateMap(updateModel *model.Player) bson.M {
 	if !(updateModel.TeamId.IsZero()) {
 		updateMap["teamId"] = updateModel.TeamId
 	}
+	if !(updateModel.ListingType == grpcPlayer.ListingType_LT_UNSPECIFIED) {
+		updateMap["listingType"] = updateModel.ListingType
+	}
+	if !(updateModel.ReservePrice == nil) {
+		updateMap["reservePrice"] = *updateModel.ReservePrice
+	}
+	if !(updateModel.AuctionEndsAt == nil) {
+		updateMap["auctionEndsAt"] = *updateModel.AuctionEndsAt
+	}
+	if !(updateModel.HighestBid == nil) {
+		updateMap["highestBid"] = *updateModel.HighestBid
+	}
+	if !(updateModel.BidCount == nil) {
+		updateMap["bidCount"] = *updateModel.BidCount
+	}
+	if !(updateModel.LoanWeeks == nil) {
+		updateMap["loanWeeks"] = *updateModel.LoanWeeks
+	}
+	if !(updateModel.LoanId == nil) {
+		updateMap["loanId"] = *updateModel.LoanId
+	}
+	if !(updateModel.ParentTeamId == nil) {
+		updateMap["parentTeamId"] = *updateModel.ParentTeamId
+	}
+	if !(updateModel.Wage == nil) {
+		updateMap["wage"] = *updateModel.Wage
+	}
+	if !(updateModel.ContractEndsAt == nil) {
+		updateMap["contractEndsAt"] = *updateModel.ContractEndsAt
+	}
 	return updateMap
 }
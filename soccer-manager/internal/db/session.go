@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/id"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateRefreshTokenIndexes backs revoking the tokens of a session or a user, expired tokens are dropped by mongo
+func CreateRefreshTokenIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "sessionId", Value: 1}}},
+		{Keys: bson.D{{Key: "userId", Value: 1}}},
+		{Keys: bson.D{{Key: "expiresAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	return err
+}
+
+// CreateRevokedSessionIndexes drops revocation list entries once the access tokens they refuse have expired
+func CreateRevokedSessionIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return err
+}
+
+type RefreshTokenDbManager interface {
+	Create(context.Context, *model.RefreshToken) (*model.RefreshToken, error)
+	Get(context.Context, id.RefreshTokenID) (*model.RefreshToken, error)
+	Find(context.Context, map[string]interface{}) ([]*model.RefreshToken, error)
+	Update(context.Context, *model.RefreshToken, ...map[string]interface{}) (*model.RefreshToken, error)
+	RevokeSession(context.Context, id.SessionID, time.Time) (int64, error)
+}
+
+type refreshToken struct {
+	collection *mongo.Collection
+}
+
+func NewRefreshTokenDbManager(collection *mongo.Collection) RefreshTokenDbManager {
+	return refreshToken{
+		collection: collection,
+	}
+}
+
+func (r refreshToken) Create(ctx context.Context, rm *model.RefreshToken) (*model.RefreshToken, error) {
+	rm.CreatedAt = time.Now()
+	rm.UpdatedAt = rm.CreatedAt
+
+	_, err := r.collection.InsertOne(ctx, rm)
+	return rm, err
+}
+
+func (r refreshToken) Get(ctx context.Context, tokenID id.RefreshTokenID) (*model.RefreshToken, error) {
+	filter := bson.D{{
+		Key:   "_id",
+		Value: tokenID,
+	}}
+	token := &model.RefreshToken{}
+	if err := r.collection.FindOne(ctx, filter).Decode(token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (r refreshToken) Update(ctx context.Context, updateModel *model.RefreshToken, filters ...map[string]interface{}) (*model.RefreshToken, error) {
+	filter := bson.M{"_id": updateModel.Id}
+	if len(filters) > 0 {
+		for key, val := range filters[0] {
+			filter[key] = val
+		}
+	}
+
+	update := bson.M{"$set": r.getUpdateMap(updateModel)}
+	token := &model.RefreshToken{}
+	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// RevokeSession revokes the tokens of the session that are not revoked yet and returns how many were
+func (r refreshToken) RevokeSession(ctx context.Context, sessionID id.SessionID, revokedAt time.Time) (int64, error) {
+	filter := bson.M{"sessionId": sessionID, "revokedAt": nil}
+	update := bson.M{"$set": bson.M{"revokedAt": revokedAt, "updatedAt": time.Now()}}
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+func (r refreshToken) Find(ctx context.Context, filters map[string]interface{}) ([]*model.RefreshToken, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	cur, err := r.collection.Find(ctx, dbFilters)
+	if err != nil {
+		return nil, err
+	}
+	var tokens []*model.RefreshToken
+	for cur.Next(ctx) {
+		token := &model.RefreshToken{}
+		if err := cur.Decode(&token); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	// once exhausted, close the cursor
+	cur.Close(ctx)
+	return tokens, nil
+}
+
+func (r refreshToken) getUpdateMap(updateModel *model.RefreshToken) bson.M {
+	updateMap := bson.M{"updatedAt": time.Now()}
+	if updateModel.UsedAt != nil {
+		updateMap["usedAt"] = updateModel.UsedAt
+	}
+	if updateModel.RevokedAt != nil {
+		updateMap["revokedAt"] = updateModel.RevokedAt
+	}
+	return updateMap
+}
+
+type RevokedSessionDbManager interface {
+	Revoke(context.Context, *model.RevokedSession) error
+	IsRevoked(context.Context, id.SessionID) (bool, error)
+}
+
+type revokedSession struct {
+	collection *mongo.Collection
+}
+
+func NewRevokedSessionDbManager(collection *mongo.Collection) RevokedSessionDbManager {
+	return revokedSession{
+		collection: collection,
+	}
+}
+
+// Revoke puts the session on the revocation list, revoking it again keeps the later expiry
+func (r revokedSession) Revoke(ctx context.Context, rm *model.RevokedSession) error {
+	rm.CreatedAt = time.Now()
+	filter := bson.M{"_id": rm.Id}
+	update := bson.M{
+		"$set":         bson.M{"userId": rm.UserId},
+		"$max":         bson.M{"expiresAt": rm.ExpiresAt},
+		"$setOnInsert": bson.M{"createdAt": rm.CreatedAt},
+	}
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r revokedSession) IsRevoked(ctx context.Context, sessionID id.SessionID) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": sessionID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -48,10 +48,12 @@ func (t team) Get(ctx context.Context, txnID id.TeamID) (*model.Team, error) {
 }
 
 func (t team) Update(ctx context.Context, updateModel *model.Team, filters ...map[string]interface{}) (*model.Team, error) {
-	filter := bson.D{{
-		Key:   "_id",
-		Value: updateModel.Id,
-	}}
+	filter := bson.M{"_id": updateModel.Id}
+	if len(filters) > 0 {
+		for key, val := range filters[0] {
+			filter[key] = val
+		}
+	}
 	update := bson.M{"$set": t.getUpdateMap(updateModel)}
 	team := &model.Team{}
 	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
@@ -101,5 +103,17 @@ func (t team) getUpdateMap(updateModel *model.Team) bson.M {
 	if !(updateModel.Budget == nil) {
 		updateMap["budget"] = *updateModel.Budget
 	}
+	if !(updateModel.Reserved == nil) {
+		updateMap["reserved"] = *updateModel.Reserved
+	}
+	if !(updateModel.Overdrawn == nil) {
+		updateMap["overdrawn"] = *updateModel.Overdrawn
+	}
+	if !(updateModel.NextPayrollAt == nil) {
+		updateMap["nextPayrollAt"] = *updateModel.NextPayrollAt
+	}
+	if !(updateModel.WageArrears == nil) {
+		updateMap["wageArrears"] = *updateModel.WageArrears
+	}
 	return updateMap
 }
@@ -16,6 +16,18 @@ type TransactionDbManager interface {
 	Get(context.Context, id.TransactionID) (*model.Transaction, error)
 	Find(context.Context, map[string]interface{}) ([]*model.Transaction, error)
 	Update(context.Context, *model.Transaction, ...map[string]interface{}) (*model.Transaction, error)
+	FindPage(context.Context, Page) ([]*model.Transaction, string, error)
+	Count(context.Context, map[string]interface{}) (int64, error)
+}
+
+// CreateTransactionIndexes backs the team transaction history, which filters on teamId and pages on createdAt or amount
+func CreateTransactionIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "teamId", Value: 1}, {Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "teamId", Value: 1}, {Key: "amount", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "createdAt", Value: -1}}},
+	})
+	return err
 }
 
 type transaction struct {
@@ -29,7 +41,10 @@ func NewTransactionDbManager(collection *mongo.Collection) TransactionDbManager
 }
 
 func (t transaction) Create(ctx context.Context, tm *model.Transaction) (*model.Transaction, error) {
-	tm.CreatedAt = time.Now()
+	//records rebuilt by a backfill keep the time of the record they are rebuilt from
+	if tm.CreatedAt.IsZero() {
+		tm.CreatedAt = time.Now()
+	}
 	_, err := t.collection.InsertOne(ctx, tm)
 	return tm, err
 }
@@ -86,6 +101,48 @@ func (t transaction) Find(ctx context.Context, filters map[string]interface{}) (
 	return transactions, nil
 }
 
+// FindPage returns one page of transactions and the cursor for the next page, which is empty on the last page
+func (t transaction) FindPage(ctx context.Context, page Page) ([]*model.Transaction, string, error) {
+	dbFilters, err := page.filter()
+	if err != nil {
+		return nil, "", err
+	}
+	cur, err := t.collection.Find(ctx, dbFilters, page.findOptions())
+	if err != nil {
+		return nil, "", err
+	}
+	defer cur.Close(ctx)
+
+	var transactions []*model.Transaction
+	var last bson.Raw
+	hasMore := false
+	for cur.Next(ctx) {
+		if int64(len(transactions)) == page.limit() {
+			hasMore = true
+			break
+		}
+		transaction := &model.Transaction{}
+		if err := cur.Decode(&transaction); err != nil {
+			return nil, "", err
+		}
+		transactions = append(transactions, transaction)
+		last = append(bson.Raw{}, cur.Current...)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if !hasMore {
+		return transactions, "", nil
+	}
+	nextCursor, err := page.nextCursor(last)
+	return transactions, nextCursor, err
+}
+
+func (t transaction) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	return t.collection.CountDocuments(ctx, Page{Filters: filters}.countFilter())
+}
+
 func (t transaction) getUpdateMap(updateModel *model.Transaction) bson.M {
 	updateMap := bson.M{}
 	return updateMap
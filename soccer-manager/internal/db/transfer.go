@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/id"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// TransferDbManager stores transfers, they are append only
+type TransferDbManager interface {
+	Create(context.Context, *model.Transfer) (*model.Transfer, error)
+	Get(context.Context, id.TransferID) (*model.Transfer, error)
+	Find(context.Context, map[string]interface{}) ([]*model.Transfer, error)
+}
+
+type transfer struct {
+	collection *mongo.Collection
+}
+
+func NewTransferDbManager(collection *mongo.Collection) TransferDbManager {
+	return transfer{
+		collection: collection,
+	}
+}
+
+func (t transfer) Create(ctx context.Context, tm *model.Transfer) (*model.Transfer, error) {
+	tm.CreatedAt = time.Now()
+	_, err := t.collection.InsertOne(ctx, tm)
+	return tm, err
+}
+
+func (t transfer) Get(ctx context.Context, txnID id.TransferID) (*model.Transfer, error) {
+	filter := bson.D{{
+		Key:   "_id",
+		Value: txnID,
+	}}
+	transfer := &model.Transfer{}
+	if err := t.collection.FindOne(ctx, filter).Decode(transfer); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func (t transfer) Find(ctx context.Context, filters map[string]interface{}) ([]*model.Transfer, error) {
+	dbFilters := bson.M{}
+	for key, val := range filters {
+		dbFilters[key] = val
+	}
+	cur, err := t.collection.Find(ctx, dbFilters)
+	if err != nil {
+		return nil, err
+	}
+	var transfers []*model.Transfer
+	for cur.Next(ctx) {
+		transfer := &model.Transfer{}
+		if err := cur.Decode(&transfer); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	// once exhausted, close the cursor
+	cur.Close(ctx)
+	return transfers, nil
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	grpcUser "protobuf-v1/golang/user"
 	"soccer-manager/internal/model"
 	"soccer-manager/util/id"
 	"time"
@@ -15,11 +16,23 @@ var (
 	After int8 = 1
 )
 
+// CreateUserIndexes makes emails unique, a second registration with the same email fails with a duplicate key error
+func CreateUserIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "email", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
 type UserDbManager interface {
 	Create(context.Context, *model.User) (*model.User, error)
 	Get(context.Context, id.UserID) (*model.User, error)
 	Find(context.Context, map[string]interface{}) ([]*model.User, error)
 	Update(context.Context, *model.User, ...map[string]interface{}) (*model.User, error)
+	EnableTOTP(context.Context, id.UserID, string, int64, []string, time.Time) (*model.User, error)
+	DisableTOTP(context.Context, id.UserID) (*model.User, error)
+	UseRecoveryCode(context.Context, id.UserID, string) (bool, error)
 }
 
 type user struct {
@@ -51,10 +64,13 @@ func (u user) Get(ctx context.Context, txnID id.UserID) (*model.User, error) {
 }
 
 func (u user) Update(ctx context.Context, updateModel *model.User, filters ...map[string]interface{}) (*model.User, error) {
-	filter := bson.D{{
-		Key:   "_id",
-		Value: updateModel.Id,
-	}}
+	filter := bson.M{"_id": updateModel.Id}
+	if len(filters) > 0 {
+		for key, val := range filters[0] {
+			filter[key] = val
+		}
+	}
+
 	update := bson.M{"$set": u.getUpdateMap(updateModel)}
 	user := &model.User{}
 	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
@@ -64,6 +80,54 @@ func (u user) Update(ctx context.Context, updateModel *model.User, filters ...ma
 	return user, nil
 }
 
+// EnableTOTP turns the pending secret into the user's secret unless it was replaced meanwhile
+func (u user) EnableTOTP(ctx context.Context, userId id.UserID, pendingSecret string, counter int64, recoveryCodes []string, at time.Time) (*model.User, error) {
+	filter := bson.M{"_id": userId, "totpPendingSecret": pendingSecret, "totpEnabledAt": nil}
+	update := bson.M{
+		"$set": bson.M{
+			"totpSecret":      pendingSecret,
+			"totpEnabledAt":   at,
+			"totpLastCounter": counter,
+			"recoveryCodes":   recoveryCodes,
+		},
+		"$unset": bson.M{"totpPendingSecret": ""},
+	}
+	user := &model.User{}
+	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
+	if err := u.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (u user) DisableTOTP(ctx context.Context, userId id.UserID) (*model.User, error) {
+	filter := bson.M{"_id": userId}
+	update := bson.M{"$unset": bson.M{
+		"totpSecret":        "",
+		"totpPendingSecret": "",
+		"totpEnabledAt":     "",
+		"totpLastCounter":   "",
+		"recoveryCodes":     "",
+	}}
+	user := &model.User{}
+	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
+	if err := u.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// UseRecoveryCode removes the hash of a recovery code in place, false when the user does not have it
+func (u user) UseRecoveryCode(ctx context.Context, userId id.UserID, codeHash string) (bool, error) {
+	filter := bson.M{"_id": userId, "recoveryCodes": codeHash}
+	update := bson.M{"$pull": bson.M{"recoveryCodes": codeHash}}
+	result, err := u.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
 func (u user) Find(ctx context.Context, filters map[string]interface{}) ([]*model.User, error) {
 	dbFilters := bson.M{}
 	for key, val := range filters {
@@ -95,5 +159,32 @@ func (u user) getUpdateMap(updateModel *model.User) bson.M {
 	if !(updateModel.Name == "") {
 		updateMap["name"] = updateModel.Name
 	}
+	if !(updateModel.Password == "") {
+		updateMap["password"] = updateModel.Password
+	}
+	if updateModel.PasswordChangedAt != nil {
+		updateMap["passwordChangedAt"] = updateModel.PasswordChangedAt
+	}
+	if updateModel.EmailVerifiedAt != nil {
+		updateMap["emailVerifiedAt"] = updateModel.EmailVerifiedAt
+	}
+	if !(updateModel.Role == grpcUser.Role_ROLE_UNSPECIFIED) {
+		updateMap["role"] = updateModel.Role
+	}
+	if updateModel.IsFrozen != nil {
+		updateMap["isFrozen"] = updateModel.IsFrozen
+	}
+	if updateModel.FrozenAt != nil {
+		updateMap["frozenAt"] = updateModel.FrozenAt
+	}
+	if !(updateModel.TotpPendingSecret == "") {
+		updateMap["totpPendingSecret"] = updateModel.TotpPendingSecret
+	}
+	if updateModel.TotpLastCounter != nil {
+		updateMap["totpLastCounter"] = updateModel.TotpLastCounter
+	}
+	if updateModel.RecoveryCodes != nil {
+		updateMap["recoveryCodes"] = updateModel.RecoveryCodes
+	}
 	return updateMap
 }
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/id"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateUserTokenIndexes backs invalidating the open tokens of a user, expired tokens are dropped by mongo
+func CreateUserTokenIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "userId", Value: 1}, {Key: "purpose", Value: 1}}},
+		{Keys: bson.D{{Key: "expiresAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	return err
+}
+
+type UserTokenDbManager interface {
+	Create(context.Context, *model.UserToken) (*model.UserToken, error)
+	Get(context.Context, id.UserTokenID) (*model.UserToken, error)
+	Update(context.Context, *model.UserToken, ...map[string]interface{}) (*model.UserToken, error)
+	UseAll(context.Context, id.UserID, model.UserTokenPurpose, time.Time) (int64, error)
+}
+
+type userToken struct {
+	collection *mongo.Collection
+}
+
+func NewUserTokenDbManager(collection *mongo.Collection) UserTokenDbManager {
+	return userToken{
+		collection: collection,
+	}
+}
+
+func (u userToken) Create(ctx context.Context, um *model.UserToken) (*model.UserToken, error) {
+	um.CreatedAt = time.Now()
+	um.UpdatedAt = um.CreatedAt
+
+	_, err := u.collection.InsertOne(ctx, um)
+	return um, err
+}
+
+func (u userToken) Get(ctx context.Context, tokenID id.UserTokenID) (*model.UserToken, error) {
+	filter := bson.D{{
+		Key:   "_id",
+		Value: tokenID,
+	}}
+	token := &model.UserToken{}
+	if err := u.collection.FindOne(ctx, filter).Decode(token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (u userToken) Update(ctx context.Context, updateModel *model.UserToken, filters ...map[string]interface{}) (*model.UserToken, error) {
+	filter := bson.M{"_id": updateModel.Id}
+	if len(filters) > 0 {
+		for key, val := range filters[0] {
+			filter[key] = val
+		}
+	}
+
+	update := bson.M{"$set": u.getUpdateMap(updateModel)}
+	token := &model.UserToken{}
+	opts := &options.FindOneAndUpdateOptions{ReturnDocument: (*options.ReturnDocument)(&After)}
+	if err := u.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// UseAll marks the open tokens of the user for the purpose used, so only the token mailed last works
+func (u userToken) UseAll(ctx context.Context, userID id.UserID, purpose model.UserTokenPurpose, usedAt time.Time) (int64, error) {
+	filter := bson.M{"userId": userID, "purpose": purpose, "usedAt": nil}
+	update := bson.M{"$set": bson.M{"usedAt": usedAt, "updatedAt": time.Now()}}
+	result, err := u.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+func (u userToken) getUpdateMap(updateModel *model.UserToken) bson.M {
+	updateMap := bson.M{"updatedAt": time.Now()}
+	if updateModel.UsedAt != nil {
+		updateMap["usedAt"] = updateModel.UsedAt
+	}
+	return updateMap
+}
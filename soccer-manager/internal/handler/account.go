@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcLoginApi "protobuf-v1/golang/external/login"
+	grpcLogin "protobuf-v1/golang/login"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/router"
+
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (c clientController) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.ChangePasswordRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	grpcReq := &grpcLogin.ChangePasswordRequest{
+		Jwt:                     router.BearerToken(r),
+		CurrentPassword:         req.CurrentPassword,
+		NewPassword:             req.NewPassword,
+		NewPasswordConfirmation: req.NewPasswordConfirmation,
+	}
+
+	_, err = c.lc.ChangePassword(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c clientController) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.ForgotPasswordRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	_, err = c.lc.ForgotPassword(r.Context(), &grpcLogin.ForgotPasswordRequest{Email: req.Email})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	//accepted whether or not the email is registered
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (c clientController) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.ResetPasswordRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	grpcReq := &grpcLogin.ResetPasswordRequest{
+		Token:                   req.Token,
+		NewPassword:             req.NewPassword,
+		NewPasswordConfirmation: req.NewPasswordConfirmation,
+	}
+
+	_, err = c.lc.ResetPassword(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c clientController) SendVerificationEmail(w http.ResponseWriter, r *http.Request) {
+	headerUserID := router.NewHeader(r.Context()).GetUserID()
+
+	_, err := c.lc.SendVerificationEmail(r.Context(), &grpcLogin.SendVerificationEmailRequest{UserId: headerUserID.String()})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (c clientController) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.VerifyEmailRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	_, err = c.lc.VerifyEmail(r.Context(), &grpcLogin.VerifyEmailRequest{Token: req.Token})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
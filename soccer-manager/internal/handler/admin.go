@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcPlayerApi "protobuf-v1/golang/external/player"
+	grpcTeamApi "protobuf-v1/golang/external/team"
+	grpcUserApi "protobuf-v1/golang/external/user"
+	grpcLogin "protobuf-v1/golang/login"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTeam "protobuf-v1/golang/team"
+	grpcTxn "protobuf-v1/golang/transaction"
+	grpcUser "protobuf-v1/golang/user"
+	"soccer-manager/util"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/router"
+
+	"github.com/go-chi/chi"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The admin routes are guarded by router.RequirePermission instead of ownership checks
+
+func (c clientController) AdminGetUser(w http.ResponseWriter, r *http.Request) {
+	userId, err := id.ParseUserID(chi.URLParam(r, ParamUserID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	user, err := c.uc.Get(r.Context(), &grpcUser.GetRequest{Id: userId.String()})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	c.renderAdminUser(w, user)
+}
+
+func (c clientController) AdminGetTeam(w http.ResponseWriter, r *http.Request) {
+	teamId, err := id.ParseTeamID(chi.URLParam(r, ParamTeamID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	team, err := c.tc.Get(r.Context(), &grpcTeam.GetRequest{Id: teamId.String()})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: c.getTeamApiResponse(team),
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) AdminGetTeamPlayers(w http.ResponseWriter, r *http.Request) {
+	teamId, err := id.ParseTeamID(chi.URLParam(r, ParamTeamID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	req := &grpcPlayer.GetByTeamRequest{TeamId: teamId.String()}
+	req.Skills, err = querySkillFilter(r.URL.Query())
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, err.Error()))
+		return
+	}
+
+	players, err := c.pc.GetByTeam(r.Context(), req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcPlayerApi.Players{}
+	apiResp.Total = players.Total
+	for _, player := range players.Players {
+		apiResp.Players = append(apiResp.Players, c.getPlayerApiResponse(player))
+	}
+
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+// AdminUpdateBudget sets the budget of any team, the difference is posted to the ledger as an adjustment
+func (c clientController) AdminUpdateBudget(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcTeamApi.BudgetRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	teamId, err := id.ParseTeamID(chi.URLParam(r, ParamTeamID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	budget, err := util.ParseAmountString(req.Budget)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, err.Error()))
+		return
+	}
+
+	team, err := c.tc.Update(r.Context(), &grpcTeam.UpdateRequest{Id: teamId.String(), Budget: wrapperspb.Int64(budget)})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: c.getTeamApiResponse(team),
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) AdminFreezeUser(w http.ResponseWriter, r *http.Request) {
+	c.adminSetFrozen(w, r, true)
+}
+
+func (c clientController) AdminUnfreezeUser(w http.ResponseWriter, r *http.Request) {
+	c.adminSetFrozen(w, r, false)
+}
+
+func (c clientController) AdminSetRole(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcUserApi.RoleRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	role, ok := util.RoleToProto[util.Role(req.Role)]
+	if !ok {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "role should be one of manager, support or admin"))
+		return
+	}
+
+	userId, ok := c.adminTargetUser(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := c.lc.SetRole(r.Context(), &grpcLogin.SetRoleRequest{UserId: userId.String(), Role: role})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	c.renderAdminUser(w, user)
+}
+
+// AdminUnlistPlayer takes a player off the market, a running auction is cancelled and its bids are released
+func (c clientController) AdminUnlistPlayer(w http.ResponseWriter, r *http.Request) {
+	playerId, err := id.ParsePlayerID(chi.URLParam(r, ParamPlayerID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	player, err := c.trc.Unlist(r.Context(), &grpcTxn.UnlistRequest{PlayerId: playerId.String()})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: c.getPlayerApiResponse(player),
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) adminSetFrozen(w http.ResponseWriter, r *http.Request, frozen bool) {
+	userId, ok := c.adminTargetUser(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := c.lc.SetFrozen(r.Context(), &grpcLogin.SetFrozenRequest{UserId: userId.String(), Frozen: frozen})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	c.renderAdminUser(w, user)
+}
+
+// adminTargetUser returns the user of the path, admins can not target themselves
+func (c clientController) adminTargetUser(w http.ResponseWriter, r *http.Request) (id.UserID, bool) {
+	userId, err := id.ParseUserID(chi.URLParam(r, ParamUserID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return id.UserID{}, false
+	}
+
+	if userId == router.NewHeader(r.Context()).GetUserID() {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_FORBIDDEN, "admins can not change their own account")))
+		return id.UserID{}, false
+	}
+	return userId, true
+}
+
+func (c clientController) renderAdminUser(w http.ResponseWriter, user *grpcUser.User) {
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: c.getUserApiResponse(user),
+	}
+
+	router.RenderJSON(resp)
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcLoginApi "protobuf-v1/golang/external/login"
+	grpcLogin "protobuf-v1/golang/login"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/router"
+
+	"github.com/go-chi/chi"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (c clientController) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.CreateApiKeyRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	grpcReq := &grpcLogin.CreateApiKeyRequest{
+		Jwt:    router.BearerToken(r),
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	}
+
+	createResp, err := c.lc.CreateApiKey(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcLoginApi.CreateApiKeyResponse{
+		ApiKey: c.getApiKeyApiResponse(createResp.ApiKey),
+		Key:    createResp.Key,
+	}
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusCreated,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	listResp, err := c.lc.ListApiKeys(r.Context(), &grpcLogin.ListApiKeysRequest{Jwt: router.BearerToken(r)})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcLoginApi.ApiKeys{}
+	for _, key := range listResp.ApiKeys {
+		apiResp.ApiKeys = append(apiResp.ApiKeys, c.getApiKeyApiResponse(key))
+	}
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+	grpcReq := &grpcLogin.RevokeApiKeyRequest{
+		Jwt: router.BearerToken(r),
+		Id:  chi.URLParam(r, ParamApiKeyID),
+	}
+
+	_, err := c.lc.RevokeApiKey(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c clientController) getApiKeyApiResponse(key *grpcLogin.ApiKey) *grpcLoginApi.ApiKey {
+	return &grpcLoginApi.ApiKey{
+		Id:         key.Id,
+		Name:       key.Name,
+		Scopes:     key.Scopes,
+		CreatedAt:  key.CreatedAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
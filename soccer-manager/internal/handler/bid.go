@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcPlayerApi "protobuf-v1/golang/external/player"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/util"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/router"
+
+	"github.com/go-chi/chi"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (c clientController) PlaceBid(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcPlayerApi.PlaceBidRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	playerId, err := id.ParsePlayerID(chi.URLParam(r, ParamPlayerID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	amount, err := util.ParseAmountString(req.Amount)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "invalid amount"))
+		return
+	}
+
+	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
+
+	bid, err := c.trc.PlaceBid(r.Context(), &grpcTxn.PlaceBidRequest{TeamId: headerTeamId.String(), PlayerId: playerId.String(), Amount: amount})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getBidApiResponse(bid)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusCreated,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) GetBids(w http.ResponseWriter, r *http.Request) {
+	playerId, err := id.ParsePlayerID(chi.URLParam(r, ParamPlayerID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	player, err := c.pc.Get(r.Context(), &grpcPlayer.GetRequest{Id: playerId.String()})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
+	req := &grpcPlayer.GetBidsRequest{PlayerId: playerId.String()}
+
+	//in a sealed auction bidders only see their own bids
+	if player.ListingType == grpcPlayer.ListingType_LT_SEALED_AUCTION && player.TeamId != headerTeamId.String() {
+		req.TeamId = headerTeamId.String()
+	}
+
+	bids, err := c.pc.GetBids(r.Context(), req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcPlayerApi.Bids{}
+	apiResp.Total = bids.Total
+	for _, bid := range bids.Bids {
+		apiResp.Bids = append(apiResp.Bids, c.getBidApiResponse(bid))
+	}
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) getBidApiResponse(bid *grpcPlayer.Bid) *grpcPlayerApi.Bid {
+	return &grpcPlayerApi.Bid{
+		Id:        bid.Id,
+		PlayerId:  bid.PlayerId,
+		TeamId:    bid.TeamId,
+		Amount:    util.ParseAmountToString(bid.Amount),
+		Status:    string(util.BidStatusFromProto[bid.Status]),
+		CreatedAt: bid.CreatedAt,
+		UpdatedAt: bid.UpdatedAt,
+		Currency:  string(util.CurrencyFromProto[bid.Currency]),
+	}
+}
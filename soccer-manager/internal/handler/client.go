@@ -2,10 +2,13 @@ package handler
 
 import (
 	"net/http"
+	grpcLoan "protobuf-v1/golang/loan"
 	grpcLogin "protobuf-v1/golang/login"
+	grpcOffer "protobuf-v1/golang/offer"
 	grpcPlayer "protobuf-v1/golang/player"
 	grpcTeam "protobuf-v1/golang/team"
 	grpcTxn "protobuf-v1/golang/transaction"
+	grpcTransfer "protobuf-v1/golang/transfer"
 	grpcUser "protobuf-v1/golang/user"
 )
 
@@ -15,6 +18,36 @@ const (
 	ParamTeamID      = "teamId"
 	ParamTxnID       = "txnId"
 	ParamPlayerID    = "playerId"
+	ParamTransferID  = "transferId"
+	ParamOfferID     = "offerId"
+	ParamLoanID      = "loanId"
+	ParamApiKeyID    = "apiKeyId"
+
+	QueryType           = "type"
+	QueryPlayerID       = "playerId"
+	QueryMinAmount      = "minAmount"
+	QueryMaxAmount      = "maxAmount"
+	QueryCreatedAfter   = "createdAfter"
+	QueryCreatedBefore  = "createdBefore"
+	QuerySort           = "sort"
+	QueryOrder          = "order"
+	QueryPageSize       = "pageSize"
+	QueryCursor         = "cursor"
+	QueryCountry        = "country"
+	QueryMinAge         = "minAge"
+	QueryMaxAge         = "maxAge"
+	QueryMinAskValue    = "minAskValue"
+	QueryMaxAskValue    = "maxAskValue"
+	QueryTeamID         = "teamId"
+	QueryName           = "name"
+	QueryMinOverall     = "minOverall"
+	QueryMaxOverall     = "maxOverall"
+	QueryMinPace        = "minPace"
+	QueryMinShooting    = "minShooting"
+	QueryMinPassing     = "minPassing"
+	QueryMinDefending   = "minDefending"
+	QueryMinGoalkeeping = "minGoalkeeping"
+	QueryMinStamina     = "minStamina"
 )
 
 type ClientController interface {
@@ -25,6 +58,23 @@ type ClientController interface {
 
 	//authorization
 	Login(http.ResponseWriter, *http.Request)
+	Register(http.ResponseWriter, *http.Request)
+	RefreshToken(http.ResponseWriter, *http.Request)
+	Logout(http.ResponseWriter, *http.Request)
+	JWKS(http.ResponseWriter, *http.Request)
+	ChangePassword(http.ResponseWriter, *http.Request)
+	ForgotPassword(http.ResponseWriter, *http.Request)
+	ResetPassword(http.ResponseWriter, *http.Request)
+	SendVerificationEmail(http.ResponseWriter, *http.Request)
+	VerifyEmail(http.ResponseWriter, *http.Request)
+	VerifyTOTP(http.ResponseWriter, *http.Request)
+	EnrollTOTP(http.ResponseWriter, *http.Request)
+	ConfirmTOTP(http.ResponseWriter, *http.Request)
+	DisableTOTP(http.ResponseWriter, *http.Request)
+	RegenerateRecoveryCodes(http.ResponseWriter, *http.Request)
+	CreateApiKey(http.ResponseWriter, *http.Request)
+	ListApiKeys(http.ResponseWriter, *http.Request)
+	RevokeApiKey(http.ResponseWriter, *http.Request)
 
 	//user
 	GetUser(http.ResponseWriter, *http.Request)
@@ -41,9 +91,53 @@ type ClientController interface {
 	GetListedPlayers(http.ResponseWriter, *http.Request)
 	UpdatePlayer(http.ResponseWriter, *http.Request)
 
+	//bid
+	PlaceBid(http.ResponseWriter, *http.Request)
+	GetBids(http.ResponseWriter, *http.Request)
+
+	//history
+	GetPlayerHistory(http.ResponseWriter, *http.Request)
+
 	//transaction
 	GetTransaction(http.ResponseWriter, *http.Request)
 	GetTransactionsByTeam(http.ResponseWriter, *http.Request)
+
+	//transfer
+	GetTransfer(http.ResponseWriter, *http.Request)
+	CreateTransfer(http.ResponseWriter, *http.Request)
+	GetTransferWindow(http.ResponseWriter, *http.Request)
+
+	//offer
+	GetOffer(http.ResponseWriter, *http.Request)
+	CreateOffer(http.ResponseWriter, *http.Request)
+	RespondOffer(http.ResponseWriter, *http.Request)
+	GetIncomingOffers(http.ResponseWriter, *http.Request)
+	GetOutgoingOffers(http.ResponseWriter, *http.Request)
+
+	//loan
+	GetLoan(http.ResponseWriter, *http.Request)
+	CreateLoan(http.ResponseWriter, *http.Request)
+	GetBorrowedLoans(http.ResponseWriter, *http.Request)
+	GetLentLoans(http.ResponseWriter, *http.Request)
+
+	//access
+	PlayerTeams(*http.Request) ([]string, error)
+	BidTeams(*http.Request) ([]string, error)
+	TransactionTeams(*http.Request) ([]string, error)
+	TransferTeams(*http.Request) ([]string, error)
+	OfferTeams(*http.Request) ([]string, error)
+	LoanTeams(*http.Request) ([]string, error)
+
+	//admin
+	GetAdminPath(string) string
+	AdminGetUser(http.ResponseWriter, *http.Request)
+	AdminGetTeam(http.ResponseWriter, *http.Request)
+	AdminGetTeamPlayers(http.ResponseWriter, *http.Request)
+	AdminUpdateBudget(http.ResponseWriter, *http.Request)
+	AdminFreezeUser(http.ResponseWriter, *http.Request)
+	AdminUnfreezeUser(http.ResponseWriter, *http.Request)
+	AdminSetRole(http.ResponseWriter, *http.Request)
+	AdminUnlistPlayer(http.ResponseWriter, *http.Request)
 }
 
 type clientController struct {
@@ -52,6 +146,9 @@ type clientController struct {
 	tc grpcTeam.TeamServiceClient
 	pc grpcPlayer.PlayerServiceClient
 	trc grpcTxn.TransactionServiceClient
+	tfc grpcTransfer.TransferServiceClient
+	oc grpcOffer.OfferServiceClient
+	lnc grpcLoan.LoanServiceClient
 }
 
 type Clients struct {
@@ -60,6 +157,9 @@ type Clients struct {
 	Tc grpcTeam.TeamServiceClient
 	Pc grpcPlayer.PlayerServiceClient
 	Trc grpcTxn.TransactionServiceClient
+	Tfc grpcTransfer.TransferServiceClient
+	Oc grpcOffer.OfferServiceClient
+	Lnc grpcLoan.LoanServiceClient
 }
 
 func NewClientController(clients *Clients) ClientController {
@@ -69,6 +169,9 @@ func NewClientController(clients *Clients) ClientController {
 		tc: clients.Tc,
 		pc: clients.Pc,
 		trc: clients.Trc,
+		tfc: clients.Tfc,
+		oc: clients.Oc,
+		lnc: clients.Lnc,
 	}
 }
 
@@ -79,3 +182,7 @@ func (cntrl *clientController) GetAPIVersion() string {
 func (cntrl *clientController) GetAPIVersionPath(p string) string {
 	return "/" + clientApiVersion + p
 }
+
+func (cntrl *clientController) GetAdminPath(p string) string {
+	return "/admin/" + clientApiVersion + p
+}
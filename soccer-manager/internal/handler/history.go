@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcPlayerApi "protobuf-v1/golang/external/player"
+	grpcPlayer "protobuf-v1/golang/player"
+	"soccer-manager/util"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/router"
+
+	"github.com/go-chi/chi"
+	grpcCodes "google.golang.org/grpc/codes"
+)
+
+// GetPlayerHistory is open to every team so that asking prices can be compared with past fees
+func (c clientController) GetPlayerHistory(w http.ResponseWriter, r *http.Request) {
+	playerId, err := id.ParsePlayerID(chi.URLParam(r, ParamPlayerID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	history, err := c.pc.GetHistory(r.Context(), &grpcPlayer.GetHistoryRequest{PlayerId: playerId.String()})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcPlayerApi.PlayerHistory{}
+	apiResp.Total = history.Total
+	for _, entry := range history.Entries {
+		apiResp.Entries = append(apiResp.Entries, c.getPlayerHistoryApiResponse(entry))
+	}
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) getPlayerHistoryApiResponse(entry *grpcPlayer.PlayerHistoryEntry) *grpcPlayerApi.PlayerHistoryEntry {
+	return &grpcPlayerApi.PlayerHistoryEntry{
+		Id:         entry.Id,
+		PlayerId:   entry.PlayerId,
+		Type:       string(util.PlayerHistoryTypeFromProto[entry.Type]),
+		FromTeamId: entry.FromTeamId,
+		ToTeamId:   entry.ToTeamId,
+		TransferId: entry.TransferId,
+		LoanId:     entry.LoanId,
+		Fee:        util.ParseAmountToString(entry.Fee),
+		OldValue:   util.ParseAmountToString(entry.OldValue),
+		NewValue:   util.ParseAmountToString(entry.NewValue),
+		OldWage:    util.ParseAmountToString(entry.OldWage),
+		NewWage:    util.ParseAmountToString(entry.NewWage),
+		CreatedAt:  entry.CreatedAt,
+		Currency:   string(util.CurrencyFromProto[entry.Currency]),
+	}
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcLoanApi "protobuf-v1/golang/external/loan"
+	grpcLoan "protobuf-v1/golang/loan"
+	"soccer-manager/util"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/router"
+
+	"github.com/go-chi/chi"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (c clientController) GetLoan(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoan.GetRequest)
+	req.Id = chi.URLParam(r, ParamLoanID)
+
+	loan, err := c.lnc.Get(r.Context(), req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getLoanApiResponse(loan)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) CreateLoan(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoanApi.CreateRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
+
+	loan, err := c.lnc.Create(r.Context(), &grpcLoan.CreateRequest{PlayerId: playerId.String(), TeamId: headerTeamId.String()})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getLoanApiResponse(loan)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusCreated,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) GetBorrowedLoans(w http.ResponseWriter, r *http.Request) {
+	c.getLoansByTeam(w, r, c.lnc.GetBorrowed)
+}
+
+func (c clientController) GetLentLoans(w http.ResponseWriter, r *http.Request) {
+	c.getLoansByTeam(w, r, c.lnc.GetLent)
+}
+
+func (c clientController) getLoansByTeam(w http.ResponseWriter, r *http.Request, list func(context.Context, *grpcLoan.GetByTeamRequest, ...grpc.CallOption) (*grpcLoan.Loans, error)) {
+	req := new(grpcLoan.GetByTeamRequest)
+	teamId, err := id.ParseTeamID(chi.URLParam(r, ParamTeamID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	req.TeamId = teamId.String()
+
+	loans, err := list(r.Context(), req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcLoanApi.Loans{}
+	apiResp.Total = loans.Total
+	for _, loan := range loans.Loans {
+		apiResp.Loans = append(apiResp.Loans, c.getLoanApiResponse(loan))
+	}
+
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) getLoanApiResponse(loan *grpcLoan.Loan) *grpcLoanApi.Loan {
+	return &grpcLoanApi.Loan{
+		Id:             loan.Id,
+		PlayerId:       loan.PlayerId,
+		LenderTeamId:   loan.LenderTeamId,
+		BorrowerTeamId: loan.BorrowerTeamId,
+		Fee:            util.ParseAmountToString(loan.Fee),
+		Status:         string(util.LoanStatusFromProto[loan.Status]),
+		StartsAt:       loan.StartsAt,
+		EndsAt:         loan.EndsAt,
+		ReturnedAt:     loan.ReturnedAt,
+		Currency:       string(util.CurrencyFromProto[loan.Currency]),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	grpcLoginApi "protobuf-v1/golang/external/login"
 	grpcLogin "protobuf-v1/golang/login"
 	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/jwt"
 	"soccer-manager/util/router"
 
 	grpcCodes "google.golang.org/grpc/codes"
@@ -35,6 +36,7 @@ func (c clientController) Login(w http.ResponseWriter, r *http.Request) {
 	grpcReq := &grpcLogin.LoginRequest{
 		Email:    req.Email,
 		Password: req.Password,
+		Ip:       router.ClientIP(r),
 	}
 
 	loginResp, err := c.lc.Login(r.Context(), grpcReq)
@@ -53,11 +55,155 @@ func (c clientController) Login(w http.ResponseWriter, r *http.Request) {
 	router.RenderJSON(resp)
 }
 
+func (c clientController) Register(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.RegisterRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	if req.Email == "" || req.Password == "" {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "invalid email/ password"))
+		return
+	}
+
+	grpcReq := &grpcLogin.RegisterRequest{
+		Email:                req.Email,
+		Password:             req.Password,
+		PasswordConfirmation: req.PasswordConfirmation,
+	}
+
+	loginResp, err := c.lc.Register(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getLoginApiResponse(loginResp)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusCreated,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.RefreshTokenRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	if req.RefreshToken == "" {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_TOKEN_INVALID, "refresh token missing"))
+		return
+	}
+
+	loginResp, err := c.lc.RefreshToken(r.Context(), &grpcLogin.RefreshTokenRequest{RefreshToken: req.RefreshToken})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getLoginApiResponse(loginResp)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) Logout(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.LogoutRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	//an empty body logs out the current session only
+	if len(body) > 0 {
+		err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+		if err != nil {
+			router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+			return
+		}
+	}
+
+	grpcReq := &grpcLogin.LogoutRequest{
+		Jwt:         router.BearerToken(r),
+		AllSessions: req.AllSessions,
+	}
+
+	_, err = c.lc.Logout(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// JWKS serves the public keys access tokens are signed with, so other services can verify tokens on their own
+func (c clientController) JWKS(w http.ResponseWriter, r *http.Request) {
+	jwksResp, err := c.lc.GetJWKS(r.Context(), &grpcLogin.GetJWKSRequest{})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	//rendered as plain json, protojson would emit the fields of the other key types empty
+	apiResp := jwt.JWKS{Keys: []jwt.JWK{}}
+	for _, key := range jwksResp.Keys {
+		apiResp.Keys = append(apiResp.Keys, jwt.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Alg: key.Alg,
+			Use: key.Use,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	resp := router.Response{
+		Writer: w,
+		Status: http.StatusOK,
+		Data:   apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
 func (c clientController) getLoginApiResponse(resp *grpcLogin.LoginResponse) *grpcLoginApi.LoginResponse {
 	return &grpcLoginApi.LoginResponse{
-		AccessToken:   resp.AccessToken,
-		TokenType:     resp.TokenType,
-		UserId:        resp.UserId,
-		TokenValidity: resp.TokenValidity,
+		AccessToken:          resp.AccessToken,
+		TokenType:            resp.TokenType,
+		UserId:               resp.UserId,
+		TokenValidity:        resp.TokenValidity,
+		RefreshToken:         resp.RefreshToken,
+		RefreshTokenValidity: resp.RefreshTokenValidity,
+		MfaRequired:          resp.MfaRequired,
+		MfaToken:             resp.MfaToken,
 	}
 }
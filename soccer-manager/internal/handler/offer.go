@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcOfferApi "protobuf-v1/golang/external/offer"
+	grpcOffer "protobuf-v1/golang/offer"
+	"soccer-manager/util"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/router"
+
+	"github.com/go-chi/chi"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (c clientController) GetOffer(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcOffer.GetRequest)
+	req.Id = chi.URLParam(r, ParamOfferID)
+
+	offer, err := c.oc.Get(r.Context(), req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getOfferApiResponse(offer)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) CreateOffer(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcOfferApi.CreateRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	amount, err := util.ParseAmountString(req.Amount)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "invalid amount"))
+		return
+	}
+
+	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
+
+	offer, err := c.oc.Create(r.Context(), &grpcOffer.CreateRequest{PlayerId: playerId.String(), TeamId: headerTeamId.String(), Amount: amount})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getOfferApiResponse(offer)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusCreated,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) RespondOffer(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcOfferApi.RespondRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	offerId, err := id.ParseOfferID(chi.URLParam(r, ParamOfferID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	action, ok := util.OfferActionToProto[util.OfferAction(req.Action)]
+	if !ok {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "invalid action"))
+		return
+	}
+
+	grpcReq := &grpcOffer.RespondRequest{
+		Id:     offerId.String(),
+		TeamId: router.NewHeader(r.Context()).GetTeamID().String(),
+		Action: action,
+	}
+
+	if action == grpcOffer.OfferAction_OA_COUNTER {
+		amount, err := util.ParseAmountString(req.Amount)
+		if err != nil {
+			router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "invalid amount"))
+			return
+		}
+		grpcReq.Amount = amount
+	}
+
+	//access check - whose turn it is to respond is checked by the offer service
+	offer, err := c.oc.Respond(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getOfferApiResponse(offer)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) GetIncomingOffers(w http.ResponseWriter, r *http.Request) {
+	c.getOffersByTeam(w, r, c.oc.GetIncoming)
+}
+
+func (c clientController) GetOutgoingOffers(w http.ResponseWriter, r *http.Request) {
+	c.getOffersByTeam(w, r, c.oc.GetOutgoing)
+}
+
+func (c clientController) getOffersByTeam(w http.ResponseWriter, r *http.Request, list func(context.Context, *grpcOffer.GetByTeamRequest, ...grpc.CallOption) (*grpcOffer.Offers, error)) {
+	req := new(grpcOffer.GetByTeamRequest)
+	teamId, err := id.ParseTeamID(chi.URLParam(r, ParamTeamID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	req.TeamId = teamId.String()
+
+	offers, err := list(r.Context(), req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcOfferApi.Offers{}
+	apiResp.Total = offers.Total
+	for _, offer := range offers.Offers {
+		apiResp.Offers = append(apiResp.Offers, c.getOfferApiResponse(offer))
+	}
+
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) getOfferApiResponse(offer *grpcOffer.Offer) *grpcOfferApi.Offer {
+	return &grpcOfferApi.Offer{
+		Id:           offer.Id,
+		PlayerId:     offer.PlayerId,
+		BuyerTeamId:  offer.BuyerTeamId,
+		SellerTeamId: offer.SellerTeamId,
+		Amount:       util.ParseAmountToString(offer.Amount),
+		Status:       string(util.OfferStatusFromProto[offer.Status]),
+		TransferId:   offer.TransferId,
+		ExpiresAt:    offer.ExpiresAt,
+		CreatedAt:    offer.CreatedAt,
+		UpdatedAt:    offer.UpdatedAt,
+		Currency:     string(util.CurrencyFromProto[offer.Currency]),
+	}
+}
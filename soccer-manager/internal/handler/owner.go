@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+	grpcLoan "protobuf-v1/golang/loan"
+	grpcOffer "protobuf-v1/golang/offer"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	grpcTransfer "protobuf-v1/golang/transfer"
+	"soccer-manager/util/router"
+
+	"github.com/go-chi/chi"
+)
+
+// PlayerTeams is the team that owns the player of the route
+func (c clientController) PlayerTeams(r *http.Request) ([]string, error) {
+	player, err := c.pc.Get(r.Context(), &grpcPlayer.GetRequest{Id: chi.URLParam(r, ParamPlayerID)})
+	if err != nil {
+		return nil, err
+	}
+	return []string{player.TeamId}, nil
+}
+
+// BidTeams is the team that owns the player of the route, the bids of an auction can be seen by every team
+func (c clientController) BidTeams(r *http.Request) ([]string, error) {
+	player, err := c.pc.Get(r.Context(), &grpcPlayer.GetRequest{Id: chi.URLParam(r, ParamPlayerID)})
+	if err != nil {
+		return nil, err
+	}
+	if player.ListingType == grpcPlayer.ListingType_LT_OPEN_AUCTION || player.ListingType == grpcPlayer.ListingType_LT_SEALED_AUCTION {
+		return []string{player.TeamId, router.NewHeader(r.Context()).GetTeamID().String()}, nil
+	}
+	return []string{player.TeamId}, nil
+}
+
+// TransactionTeams is the team of the transaction of the route
+func (c clientController) TransactionTeams(r *http.Request) ([]string, error) {
+	txn, err := c.trc.Get(r.Context(), &grpcTxn.GetRequest{Id: chi.URLParam(r, ParamTxnID)})
+	if err != nil {
+		return nil, err
+	}
+	return []string{txn.TeamId}, nil
+}
+
+// TransferTeams are the buying and the selling team of the transfer of the route
+func (c clientController) TransferTeams(r *http.Request) ([]string, error) {
+	transfer, err := c.tfc.Get(r.Context(), &grpcTransfer.GetRequest{Id: chi.URLParam(r, ParamTransferID)})
+	if err != nil {
+		return nil, err
+	}
+	return []string{transfer.BuyerTeamId, transfer.SellerTeamId}, nil
+}
+
+// OfferTeams are the buying and the selling team of the offer of the route
+func (c clientController) OfferTeams(r *http.Request) ([]string, error) {
+	offer, err := c.oc.Get(r.Context(), &grpcOffer.GetRequest{Id: chi.URLParam(r, ParamOfferID)})
+	if err != nil {
+		return nil, err
+	}
+	return []string{offer.BuyerTeamId, offer.SellerTeamId}, nil
+}
+
+// LoanTeams are the lending and the borrowing team of the loan of the route
+func (c clientController) LoanTeams(r *http.Request) ([]string, error) {
+	loan, err := c.lnc.Get(r.Context(), &grpcLoan.GetRequest{Id: chi.URLParam(r, ParamLoanID)})
+	if err != nil {
+		return nil, err
+	}
+	return []string{loan.LenderTeamId, loan.BorrowerTeamId}, nil
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 	grpcRoot "protobuf-v1/golang"
@@ -21,20 +22,12 @@ func (c clientController) GetPlayer(w http.ResponseWriter, r *http.Request) {
 	req := new(grpcPlayer.GetRequest)
 	req.Id = chi.URLParam(r, ParamPlayerID)
 
-	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
-
 	player, err := c.pc.Get(r.Context(), req)
 	if err != nil {
 		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
 		return
 	}
 
-	//access check
-	if player.TeamId != headerTeamId.String() {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
-		return
-	}
-
 	apiResp := c.getPlayerApiResponse(player)
 	resp := router.Response{
 		Writer:   w,
@@ -46,7 +39,11 @@ func (c clientController) GetPlayer(w http.ResponseWriter, r *http.Request) {
 }
 
 func (c clientController) GetListedPlayers(w http.ResponseWriter, r *http.Request) {
-	req := new(grpcPlayer.GetListedRequest)
+	req, err := c.getListedQuery(r)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, err.Error()))
+		return
+	}
 
 	players, err := c.pc.GetListed(r.Context(), req)
 	if err != nil {
@@ -56,6 +53,7 @@ func (c clientController) GetListedPlayers(w http.ResponseWriter, r *http.Reques
 
 	apiResp := &grpcPlayerApi.Players{}
 	apiResp.Total = players.Total
+	apiResp.NextCursor = players.NextCursor
 	for _, player := range players.Players {
 		apiResp.Players = append(apiResp.Players, c.getPlayerApiResponse(player))
 	}
@@ -68,6 +66,49 @@ func (c clientController) GetListedPlayers(w http.ResponseWriter, r *http.Reques
 	router.RenderJSON(resp)
 }
 
+// getListedQuery reads the transfer market filters, sorting and page from the query string
+func (c clientController) getListedQuery(r *http.Request) (*grpcPlayer.GetListedRequest, error) {
+	query := r.URL.Query()
+	req := &grpcPlayer.GetListedRequest{
+		Country: query.Get(QueryCountry),
+		TeamId:  query.Get(QueryTeamID),
+		Name:    query.Get(QueryName),
+		Cursor:  query.Get(QueryCursor),
+	}
+
+	var ok bool
+	if req.Type, ok = util.PlayerTypeToProto[util.PlayerType(query.Get(QueryType))]; !ok {
+		return nil, errors.New("invalid type")
+	}
+	if req.SortBy, ok = util.PlayerSortFieldToProto[util.PlayerSortField(query.Get(QuerySort))]; !ok {
+		return nil, errors.New("invalid sort")
+	}
+	if req.SortOrder, ok = util.SortOrderToProto[util.SortOrder(query.Get(QueryOrder))]; !ok {
+		return nil, errors.New("invalid order")
+	}
+
+	var err error
+	if req.MinAge, err = queryInt32(query, QueryMinAge); err != nil {
+		return nil, err
+	}
+	if req.MaxAge, err = queryInt32(query, QueryMaxAge); err != nil {
+		return nil, err
+	}
+	if req.MinAskValue, err = queryAmount(query, QueryMinAskValue); err != nil {
+		return nil, err
+	}
+	if req.MaxAskValue, err = queryAmount(query, QueryMaxAskValue); err != nil {
+		return nil, err
+	}
+	if req.PageSize, err = queryPageSize(query); err != nil {
+		return nil, err
+	}
+	if req.Skills, err = querySkillFilter(query); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 func (c clientController) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
 	req := new(grpcPlayerApi.UpdateRequest)
 	body, err := ioutil.ReadAll(r.Body)
@@ -82,8 +123,6 @@ func (c clientController) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
-
 	playerId, err := id.ParsePlayerID(chi.URLParam(r, ParamPlayerID))
 	if err != nil {
 		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
@@ -96,12 +135,6 @@ func (c clientController) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	//access check
-	if player.TeamId != headerTeamId.String() {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
-		return
-	}
-
 	grpcReq := &grpcPlayer.UpdateRequest{
 		Id:        playerId.String(),
 		FirstName: req.FirstName,
@@ -123,6 +156,31 @@ func (c clientController) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
 		grpcReq.AskValue = &wrapperspb.Int64Value{Value: askValue}
 	}
 
+	if req.ListingType != "" {
+		listingType, ok := util.ListingTypeToProto[util.ListingType(req.ListingType)]
+		if !ok {
+			router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "invalid listing type"))
+			return
+		}
+		grpcReq.ListingType = listingType
+	}
+
+	if req.ReservePrice != nil {
+		reservePrice, err := util.ParseAmountString(req.ReservePrice.Value)
+		if err != nil {
+			router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "invalid reserve price"))
+			return
+		}
+		if reservePrice <= 0 {
+			router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "reserve price should be greater than 0"))
+			return
+		}
+		grpcReq.ReservePrice = &wrapperspb.Int64Value{Value: reservePrice}
+	}
+
+	grpcReq.AuctionEndsAt = req.AuctionEndsAt
+	grpcReq.LoanWeeks = req.LoanWeeks
+
 	player, err = c.pc.Update(r.Context(), grpcReq)
 	if err != nil {
 		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
@@ -148,11 +206,10 @@ func (c clientController) GetPlayersByTeam(w http.ResponseWriter, r *http.Reques
 	}
 
 	req.TeamId = teamId.String()
-	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
 
-	//access check
-	if req.TeamId != headerTeamId.String() {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
+	req.Skills, err = querySkillFilter(r.URL.Query())
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, err.Error()))
 		return
 	}
 
@@ -179,21 +236,52 @@ func (c clientController) GetPlayersByTeam(w http.ResponseWriter, r *http.Reques
 
 func (c clientController) getPlayerApiResponse(player *grpcPlayer.Player) *grpcPlayerApi.Player {
 	playerResp := &grpcPlayerApi.Player{
-		Id:        player.Id,
-		FirstName: player.FirstName,
-		LastName:  player.LastName,
-		Age:       player.Age,
-		Type:      string(util.PlayerTypeFromProto[player.Type]),
-		Country:   player.Country,
-		TeamId:    player.TeamId,
-		Value:     util.ParseAmountToString(player.Value),
-		IsListed:  player.IsListed,
-		Currency:  string(util.CurrencyFromProto[player.Currency]),
+		Id:             player.Id,
+		FirstName:      player.FirstName,
+		LastName:       player.LastName,
+		Age:            player.Age,
+		Type:           string(util.PlayerTypeFromProto[player.Type]),
+		Country:        player.Country,
+		TeamId:         player.TeamId,
+		Value:          util.ParseAmountToString(player.Value),
+		IsListed:       player.IsListed,
+		Currency:       string(util.CurrencyFromProto[player.Currency]),
+		ListingType:    string(util.ListingTypeFromProto[player.ListingType]),
+		AuctionEndsAt:  player.AuctionEndsAt,
+		BidCount:       player.BidCount,
+		LoanWeeks:      player.LoanWeeks,
+		LoanId:         player.LoanId,
+		ParentTeamId:   player.ParentTeamId,
+		ContractEndsAt: player.ContractEndsAt,
+	}
+
+	if player.Skills != nil {
+		playerResp.Skills = &grpcPlayerApi.PlayerSkills{
+			Pace:        player.Skills.Pace,
+			Shooting:    player.Skills.Shooting,
+			Passing:     player.Skills.Passing,
+			Defending:   player.Skills.Defending,
+			Goalkeeping: player.Skills.Goalkeeping,
+			Stamina:     player.Skills.Stamina,
+			Overall:     player.Skills.Overall,
+		}
+	}
+
+	if player.Wage != 0 {
+		playerResp.Wage = util.ParseAmountToString(player.Wage)
 	}
 
 	if player.AskValue != nil {
 		playerResp.AskValue = &wrapperspb.StringValue{Value: util.ParseAmountToString(player.AskValue.Value)}
 	}
 
+	if player.ReservePrice != nil {
+		playerResp.ReservePrice = &wrapperspb.StringValue{Value: util.ParseAmountToString(player.ReservePrice.Value)}
+	}
+
+	if player.HighestBid != nil {
+		playerResp.HighestBid = &wrapperspb.StringValue{Value: util.ParseAmountToString(player.HighestBid.Value)}
+	}
+
 	return playerResp
 }
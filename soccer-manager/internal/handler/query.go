@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"fmt"
+	"net/url"
+	grpcPlayer "protobuf-v1/golang/player"
+	"soccer-manager/util"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// queryAmount reads an optional amount such as "10.00", nil when the parameter is not set
+func queryAmount(query url.Values, key string) (*wrapperspb.Int64Value, error) {
+	val := query.Get(key)
+	if val == "" {
+		return nil, nil
+	}
+	amount, err := util.ParseAmountString(val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", key)
+	}
+	return wrapperspb.Int64(amount), nil
+}
+
+// queryInt32 reads an optional whole number, nil when the parameter is not set
+func queryInt32(query url.Values, key string) (*wrapperspb.Int32Value, error) {
+	val := query.Get(key)
+	if val == "" {
+		return nil, nil
+	}
+	number, err := strconv.ParseInt(val, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", key)
+	}
+	return wrapperspb.Int32(int32(number)), nil
+}
+
+// queryTime reads an optional RFC 3339 time, nil when the parameter is not set
+func queryTime(query url.Values, key string) (*timestamppb.Timestamp, error) {
+	val := query.Get(key)
+	if val == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", key)
+	}
+	return timestamppb.New(t), nil
+}
+
+// querySkillFilter reads the overall rating range and the minimum skills, nil when none of them is set
+func querySkillFilter(query url.Values) (*grpcPlayer.SkillFilter, error) {
+	filter := &grpcPlayer.SkillFilter{}
+	params := map[string]**wrapperspb.Int32Value{
+		QueryMinOverall:     &filter.MinOverall,
+		QueryMaxOverall:     &filter.MaxOverall,
+		QueryMinPace:        &filter.MinPace,
+		QueryMinShooting:    &filter.MinShooting,
+		QueryMinPassing:     &filter.MinPassing,
+		QueryMinDefending:   &filter.MinDefending,
+		QueryMinGoalkeeping: &filter.MinGoalkeeping,
+		QueryMinStamina:     &filter.MinStamina,
+	}
+
+	isSet := false
+	for key, field := range params {
+		val, err := queryInt32(query, key)
+		if err != nil {
+			return nil, err
+		}
+		if val != nil {
+			*field = val
+			isSet = true
+		}
+	}
+	if !isSet {
+		return nil, nil
+	}
+	return filter, nil
+}
+
+// queryPageSize reads the page size, 0 lets the service use its default
+func queryPageSize(query url.Values) (int32, error) {
+	pageSize, err := queryInt32(query, QueryPageSize)
+	if err != nil {
+		return 0, err
+	}
+	if pageSize == nil {
+		return 0, nil
+	}
+	if pageSize.Value <= 0 {
+		return 0, fmt.Errorf("invalid %s", QueryPageSize)
+	}
+	return pageSize.Value, nil
+}
@@ -20,14 +20,6 @@ func (c clientController) GetTeam(w http.ResponseWriter, r *http.Request) {
 	req := new(grpcTeam.GetRequest)
 	req.Id = chi.URLParam(r, ParamTeamID)
 
-	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
-
-	//access check
-	if req.Id != headerTeamId.String() {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
-		return
-	}
-
 	team, err := c.tc.Get(r.Context(), req)
 	if err != nil {
 		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
@@ -64,14 +56,6 @@ func (c clientController) UpdateTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
-
-	//access check
-	if headerTeamId != teamId {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
-		return
-	}
-
 	grpcReq := &grpcTeam.UpdateRequest{
 		Id:      teamId.String(),
 		Name:    req.Name,
@@ -96,12 +80,17 @@ func (c clientController) UpdateTeam(w http.ResponseWriter, r *http.Request) {
 
 func (c clientController) getTeamApiResponse(team *grpcTeam.Team) *grpcTeamApi.Team {
 	return &grpcTeamApi.Team{
-		Id:       team.Id,
-		Name:     team.Name,
-		Country:  team.Country,
-		Value:    util.ParseAmountToString(team.Value),
-		Budget:   util.ParseAmountToString(team.Budget),
-		UserId:   team.UserId,
-		Currency: string(util.CurrencyFromProto[team.Currency]),
+		Id:            team.Id,
+		Name:          team.Name,
+		Country:       team.Country,
+		Value:         util.ParseAmountToString(team.Value),
+		Budget:        util.ParseAmountToString(team.Budget),
+		Reserved:      util.ParseAmountToString(team.Reserved),
+		Available:     util.ParseAmountToString(team.Available),
+		UserId:        team.UserId,
+		Currency:      string(util.CurrencyFromProto[team.Currency]),
+		IsOverdrawn:   team.IsOverdrawn,
+		NextPayrollAt: team.NextPayrollAt,
+		WageArrears:   util.ParseAmountToString(team.WageArrears),
 	}
 }
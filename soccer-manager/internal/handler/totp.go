@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcLoginApi "protobuf-v1/golang/external/login"
+	grpcLogin "protobuf-v1/golang/login"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/router"
+
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// VerifyTOTP is the second login step, it exchanges the mfa token of the login response and a code for the tokens
+func (c clientController) VerifyTOTP(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.VerifyTOTPRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	if req.MfaToken == "" || (req.Code == "" && req.RecoveryCode == "") {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, "mfa_token and code or recovery_code are required"))
+		return
+	}
+
+	grpcReq := &grpcLogin.VerifyTOTPRequest{
+		MfaToken:     req.MfaToken,
+		Code:         req.Code,
+		RecoveryCode: req.RecoveryCode,
+		Ip:           router.ClientIP(r),
+	}
+
+	loginResp, err := c.lc.VerifyTOTP(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: c.getLoginApiResponse(loginResp),
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	enrollResp, err := c.lc.EnrollTOTP(r.Context(), &grpcLogin.EnrollTOTPRequest{Jwt: router.BearerToken(r)})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcLoginApi.EnrollTOTPResponse{
+		Secret:          enrollResp.Secret,
+		ProvisioningUri: enrollResp.ProvisioningUri,
+	}
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.ConfirmTOTPRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	codesResp, err := c.lc.ConfirmTOTP(r.Context(), &grpcLogin.ConfirmTOTPRequest{Jwt: router.BearerToken(r), Code: req.Code})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	c.renderRecoveryCodes(w, codesResp)
+}
+
+func (c clientController) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.DisableTOTPRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	grpcReq := &grpcLogin.DisableTOTPRequest{
+		Jwt:          router.BearerToken(r),
+		Password:     req.Password,
+		Code:         req.Code,
+		RecoveryCode: req.RecoveryCode,
+	}
+
+	_, err = c.lc.DisableTOTP(r.Context(), grpcReq)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c clientController) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcLoginApi.RegenerateRecoveryCodesRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	codesResp, err := c.lc.RegenerateRecoveryCodes(r.Context(), &grpcLogin.RegenerateRecoveryCodesRequest{Jwt: router.BearerToken(r), Code: req.Code})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	c.renderRecoveryCodes(w, codesResp)
+}
+
+func (c clientController) renderRecoveryCodes(w http.ResponseWriter, codesResp *grpcLogin.RecoveryCodesResponse) {
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: &grpcLoginApi.RecoveryCodesResponse{RecoveryCodes: codesResp.RecoveryCodes},
+	}
+
+	router.RenderJSON(resp)
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 	grpcRoot "protobuf-v1/golang"
@@ -15,6 +16,7 @@ import (
 	"github.com/go-chi/chi"
 	grpcCodes "google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func (c clientController) GetTransaction(w http.ResponseWriter, r *http.Request) {
@@ -27,14 +29,6 @@ func (c clientController) GetTransaction(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
-
-	//access check
-	if headerTeamId.String() != txn.TeamId {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
-		return
-	}
-
 	apiResp := c.getTxnApiResponse(txn)
 	resp := router.Response{
 		Writer:   w,
@@ -46,21 +40,18 @@ func (c clientController) GetTransaction(w http.ResponseWriter, r *http.Request)
 }
 
 func (c clientController) GetTransactionsByTeam(w http.ResponseWriter, r *http.Request) {
-	req := new(grpcTxn.GetByTeamRequest)
-	teamId, err := id.ParseTeamID(chi.URLParam(r, ParamTeamID))
+	req, err := c.getTransactionsQuery(r)
 	if err != nil {
-		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ARGS, err.Error()))
 		return
 	}
-	req.TeamId = teamId.String()
-	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
 
-	//access check
-	if headerTeamId != teamId {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
+	teamId, err := id.ParseTeamID(chi.URLParam(r, ParamTeamID))
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
 		return
 	}
-
+	req.TeamId = teamId.String()
 	txns, err := c.trc.GetByTeam(r.Context(), req)
 	if err != nil {
 		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
@@ -69,6 +60,7 @@ func (c clientController) GetTransactionsByTeam(w http.ResponseWriter, r *http.R
 
 	apiResp := &grpcTxnApi.Transactions{}
 	apiResp.Total = txns.Total
+	apiResp.NextCursor = txns.NextCursor
 	for _, txn := range txns.Transactions {
 		apiResp.Transactions = append(apiResp.Transactions, c.getTxnApiResponse(txn))
 	}
@@ -82,6 +74,44 @@ func (c clientController) GetTransactionsByTeam(w http.ResponseWriter, r *http.R
 	router.RenderJSON(resp)
 }
 
+// getTransactionsQuery reads the filters, sorting and page of the transaction history from the query string
+func (c clientController) getTransactionsQuery(r *http.Request) (*grpcTxn.GetByTeamRequest, error) {
+	query := r.URL.Query()
+	req := &grpcTxn.GetByTeamRequest{
+		PlayerId: query.Get(QueryPlayerID),
+		Cursor:   query.Get(QueryCursor),
+	}
+
+	var ok bool
+	if req.Type, ok = util.TransactionTypeToProto[util.TransactionType(query.Get(QueryType))]; !ok {
+		return nil, errors.New("invalid type")
+	}
+	if req.SortBy, ok = util.TransactionSortFieldToProto[util.TransactionSortField(query.Get(QuerySort))]; !ok {
+		return nil, errors.New("invalid sort")
+	}
+	if req.SortOrder, ok = util.SortOrderToProto[util.SortOrder(query.Get(QueryOrder))]; !ok {
+		return nil, errors.New("invalid order")
+	}
+
+	var err error
+	if req.MinAmount, err = queryAmount(query, QueryMinAmount); err != nil {
+		return nil, err
+	}
+	if req.MaxAmount, err = queryAmount(query, QueryMaxAmount); err != nil {
+		return nil, err
+	}
+	if req.CreatedAfter, err = queryTime(query, QueryCreatedAfter); err != nil {
+		return nil, err
+	}
+	if req.CreatedBefore, err = queryTime(query, QueryCreatedBefore); err != nil {
+		return nil, err
+	}
+	if req.PageSize, err = queryPageSize(query); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 func (c clientController) BuyPlayer(w http.ResponseWriter, r *http.Request) {
 	req := new(grpcTxnApi.BuyRequest)
 	body, err := ioutil.ReadAll(r.Body)
@@ -138,16 +168,21 @@ func (c clientController) BuyPlayer(w http.ResponseWriter, r *http.Request) {
 }
 
 func (c clientController) getTxnApiResponse(txn *grpcTxn.Transaction) *grpcTxnApi.Transaction {
-	return &grpcTxnApi.Transaction{
+	txnResp := &grpcTxnApi.Transaction{
 		Id:          txn.Id,
 		Title:       txn.Title,
 		Description: txn.Description,
 		TeamId:      txn.TeamId,
 		Amount:      util.ParseAmountToString(txn.Amount),
-		Budget:      util.ParseAmountToString(txn.Budget),
 		PlayerId:    txn.PlayerId,
 		CreatedAt:   txn.CreatedAt,
 		Type:        string(util.TransactionTypeFromProto[txn.Type]),
 		Currency:    string(util.CurrencyFromProto[txn.Currency]),
+		TransferId:  txn.TransferId,
+		LoanId:      txn.LoanId,
+	}
+	if txn.Budget != nil {
+		txnResp.Budget = &wrapperspb.StringValue{Value: util.ParseAmountToString(txn.Budget.Value)}
 	}
+	return txnResp
 }
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcTransferApi "protobuf-v1/golang/external/transfer"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTransfer "protobuf-v1/golang/transfer"
+	"soccer-manager/util"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/router"
+
+	"github.com/go-chi/chi"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (c clientController) GetTransfer(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcTransfer.GetRequest)
+	req.Id = chi.URLParam(r, ParamTransferID)
+
+	transfer, err := c.tfc.Get(r.Context(), req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getTransferApiResponse(transfer)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+	req := new(grpcTransferApi.CreateRequest)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	err = protojson.UnmarshalOptions{}.Unmarshal(body, req)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INTERNAL_ERROR, err.Error()))
+		return
+	}
+
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewRESTError(r.Method, grpcCodes.InvalidArgument, grpcRoot.Error_ERROR_INVALID_ID, err.Error()))
+		return
+	}
+
+	player, err := c.pc.Get(r.Context(), &grpcPlayer.GetRequest{Id: playerId.String()})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	headerTeamId := router.NewHeader(r.Context()).GetTeamID()
+
+	//access check
+	if player.IsListed != true {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR, "player not listed")))
+		return
+	}
+
+	if player.TeamId == headerTeamId.String() {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR, "cannot buy own player")))
+		return
+	}
+
+	transfer, err := c.tfc.Create(r.Context(), &grpcTransfer.CreateRequest{PlayerId: playerId.String(), TeamId: headerTeamId.String(), Description: req.Description})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := c.getTransferApiResponse(transfer)
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusCreated,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) GetTransferWindow(w http.ResponseWriter, r *http.Request) {
+	window, err := c.tfc.GetWindow(r.Context(), &grpcTransfer.GetWindowRequest{})
+	if err != nil {
+		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+		return
+	}
+
+	apiResp := &grpcTransferApi.GetWindowResponse{
+		IsOpen:  window.IsOpen,
+		Current: c.getTransferWindowApiResponse(window.Current),
+		Next:    c.getTransferWindowApiResponse(window.Next),
+	}
+	resp := router.Response{
+		Writer:   w,
+		Status:   http.StatusOK,
+		GRPCData: apiResp,
+	}
+
+	router.RenderJSON(resp)
+}
+
+func (c clientController) getTransferWindowApiResponse(window *grpcTransfer.TransferWindow) *grpcTransferApi.TransferWindow {
+	if window == nil {
+		return nil
+	}
+	return &grpcTransferApi.TransferWindow{
+		OpensAt:  window.OpensAt,
+		ClosesAt: window.ClosesAt,
+	}
+}
+
+func (c clientController) getTransferApiResponse(transfer *grpcTransfer.Transfer) *grpcTransferApi.Transfer {
+	return &grpcTransferApi.Transfer{
+		Id:           transfer.Id,
+		BuyerTeamId:  transfer.BuyerTeamId,
+		SellerTeamId: transfer.SellerTeamId,
+		Amount:       util.ParseAmountToString(transfer.Amount),
+		PlayerId:     transfer.PlayerId,
+		CreatedAt:    transfer.CreatedAt,
+		Currency:     string(util.CurrencyFromProto[transfer.Currency]),
+	}
+}
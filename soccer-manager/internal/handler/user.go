@@ -6,6 +6,7 @@ import (
 	grpcRoot "protobuf-v1/golang"
 	grpcUserApi "protobuf-v1/golang/external/user"
 	grpcUser "protobuf-v1/golang/user"
+	"soccer-manager/util"
 	grpcError "soccer-manager/util/error"
 	"soccer-manager/util/id"
 	"soccer-manager/util/router"
@@ -19,14 +20,6 @@ func (c clientController) GetUser(w http.ResponseWriter, r *http.Request) {
 	req := new(grpcUser.GetRequest)
 	req.Id = chi.URLParam(r, ParamUserID)
 
-	headerUserID := router.NewHeader(r.Context()).GetUserID()
-
-	//access check
-	if req.Id != headerUserID.String() {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
-		return
-	}
-
 	user, err := c.uc.Get(r.Context(), req)
 	if err != nil {
 		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
@@ -63,14 +56,6 @@ func (c clientController) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	headerUserID := router.NewHeader(r.Context()).GetUserID()
-
-	//access check
-	if userId != headerUserID {
-		router.RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
-		return
-	}
-
 	grpcReq := &grpcUser.UpdateRequest{
 		Id:   userId.String(),
 		Name: req.Name,
@@ -94,10 +79,14 @@ func (c clientController) UpdateUser(w http.ResponseWriter, r *http.Request) {
 
 func (c clientController) getUserApiResponse(user *grpcUser.User) *grpcUserApi.User {
 	return &grpcUserApi.User{
-		Id:        user.Id,
-		Name:      user.Name,
-		Email:     user.Email,
-		TeamId:    user.TeamId,
-		CreatedAt: user.CreatedAt,
+		Id:            user.Id,
+		Name:          user.Name,
+		Email:         user.Email,
+		TeamId:        user.TeamId,
+		CreatedAt:     user.CreatedAt,
+		EmailVerified: user.EmailVerified,
+		Role:          string(util.RoleFromProto[user.Role]),
+		IsFrozen:      user.IsFrozen,
+		TotpEnabled:   user.TotpEnabled,
 	}
 }
@@ -0,0 +1,41 @@
+package model
+
+import (
+	grpcLogin "protobuf-v1/golang/login"
+	"soccer-manager/util"
+	"soccer-manager/util/id"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ApiKey is a named long lived credential of a user for scripts, only the hash of its secret is stored
+type ApiKey struct {
+	Id         id.ApiKeyID        `bson:"_id"`
+	UserId     id.UserID          `bson:"userId"`
+	Name       string             `bson:"name"`
+	Scopes     []util.ApiKeyScope `bson:"scopes"`
+	SecretHash string             `bson:"secretHash"`
+	LastUsedAt *time.Time         `bson:"lastUsedAt"`
+	RevokedAt  *time.Time         `bson:"revokedAt"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updatedAt"`
+}
+
+func (a ApiKey) ToProto() *grpcLogin.ApiKey {
+	apiKey := &grpcLogin.ApiKey{
+		Id:        a.Id.String(),
+		Name:      a.Name,
+		CreatedAt: timestamppb.New(a.CreatedAt),
+	}
+	for _, scope := range a.Scopes {
+		apiKey.Scopes = append(apiKey.Scopes, string(scope))
+	}
+	if a.LastUsedAt != nil {
+		apiKey.LastUsedAt = timestamppb.New(*a.LastUsedAt)
+	}
+	if a.RevokedAt != nil {
+		apiKey.RevokedAt = timestamppb.New(*a.RevokedAt)
+	}
+	return apiKey
+}
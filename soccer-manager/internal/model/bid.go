@@ -0,0 +1,35 @@
+package model
+
+import (
+	"protobuf-v1/golang"
+	grpcPlayer "protobuf-v1/golang/player"
+	"soccer-manager/util/id"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Bid struct {
+	Id           id.BidID             `bson:"_id"`
+	PlayerId     id.PlayerID          `bson:"playerId"`
+	TeamId       id.TeamID            `bson:"teamId"`
+	SellerTeamId id.TeamID            `bson:"sellerTeamId"`
+	Amount       int64                `bson:"amount"`
+	Status       grpcPlayer.BidStatus `bson:"status"`
+	Currency     golang.Currency      `bson:"currency"`
+	CreatedAt    time.Time            `bson:"createdAt"`
+	UpdatedAt    time.Time            `bson:"updatedAt"`
+}
+
+func (b Bid) ToProto() *grpcPlayer.Bid {
+	return &grpcPlayer.Bid{
+		Id:        b.Id.String(),
+		PlayerId:  b.PlayerId.String(),
+		TeamId:    b.TeamId.String(),
+		Amount:    b.Amount,
+		Status:    b.Status,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+		UpdatedAt: timestamppb.New(b.UpdatedAt),
+		Currency:  b.Currency,
+	}
+}
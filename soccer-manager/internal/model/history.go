@@ -0,0 +1,47 @@
+package model
+
+import (
+	"protobuf-v1/golang"
+	grpcPlayer "protobuf-v1/golang/player"
+	"soccer-manager/util/id"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PlayerHistoryEntry records a move, value change, loan or contract renewal of a player
+type PlayerHistoryEntry struct {
+	Id         id.PlayerHistoryID           `bson:"_id"`
+	PlayerId   id.PlayerID                  `bson:"playerId"`
+	Type       grpcPlayer.PlayerHistoryType `bson:"type"`
+	FromTeamId id.TeamID                    `bson:"fromTeamId"`
+	ToTeamId   id.TeamID                    `bson:"toTeamId"`
+	TransferId id.TransferID                `bson:"transferId"`
+	LoanId     id.LoanID                    `bson:"loanId"`
+	Fee        int64                        `bson:"fee"`
+	OldValue   int64                        `bson:"oldValue"`
+	NewValue   int64                        `bson:"newValue"`
+	OldWage    int64                        `bson:"oldWage"`
+	NewWage    int64                        `bson:"newWage"`
+	Currency   golang.Currency              `bson:"currency"`
+	CreatedAt  time.Time                    `bson:"createdAt"`
+}
+
+func (h PlayerHistoryEntry) ToProto() *grpcPlayer.PlayerHistoryEntry {
+	return &grpcPlayer.PlayerHistoryEntry{
+		Id:         h.Id.String(),
+		PlayerId:   h.PlayerId.String(),
+		Type:       h.Type,
+		FromTeamId: h.FromTeamId.String(),
+		ToTeamId:   h.ToTeamId.String(),
+		TransferId: h.TransferId.String(),
+		LoanId:     h.LoanId.String(),
+		Fee:        h.Fee,
+		OldValue:   h.OldValue,
+		NewValue:   h.NewValue,
+		OldWage:    h.OldWage,
+		NewWage:    h.NewWage,
+		CreatedAt:  timestamppb.New(h.CreatedAt),
+		Currency:   h.Currency,
+	}
+}
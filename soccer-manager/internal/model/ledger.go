@@ -0,0 +1,42 @@
+package model
+
+import (
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/util/id"
+	"time"
+)
+
+// LedgerEntry is one balanced money movement, the amounts of its postings always add up to zero
+type LedgerEntry struct {
+	Id          id.LedgerEntryID `bson:"_id"`
+	TransferId  id.TransferID    `bson:"transferId"`
+	Description string           `bson:"description"`
+	Postings    []Posting        `bson:"postings"`
+	CreatedAt   time.Time        `bson:"createdAt"`
+}
+
+// Posting credits amount to an account, a negative amount is a debit. Team budget postings carry the team id
+type Posting struct {
+	Account grpcTxn.LedgerAccount `bson:"account"`
+	TeamId  id.TeamID             `bson:"teamId"`
+	Amount  int64                 `bson:"amount"`
+}
+
+func (l LedgerEntry) IsBalanced() bool {
+	var total int64
+	for _, posting := range l.Postings {
+		total += posting.Amount
+	}
+	return len(l.Postings) > 1 && total == 0
+}
+
+// BudgetChange is the net amount the entry moves in or out of the team's budget
+func (l LedgerEntry) BudgetChange(teamId id.TeamID) int64 {
+	var change int64
+	for _, posting := range l.Postings {
+		if posting.Account == grpcTxn.LedgerAccount_LA_TEAM_BUDGET && posting.TeamId == teamId {
+			change += posting.Amount
+		}
+	}
+	return change
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"protobuf-v1/golang"
+	grpcLoan "protobuf-v1/golang/loan"
+	"soccer-manager/util/id"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Loan struct {
+	Id             id.LoanID           `bson:"_id"`
+	PlayerId       id.PlayerID         `bson:"playerId"`
+	LenderTeamId   id.TeamID           `bson:"lenderTeamId"`
+	BorrowerTeamId id.TeamID           `bson:"borrowerTeamId"`
+	Fee            int64               `bson:"fee"`
+	Status         grpcLoan.LoanStatus `bson:"status"`
+	StartsAt       time.Time           `bson:"startsAt"`
+	EndsAt         time.Time           `bson:"endsAt"`
+	ReturnedAt     *time.Time          `bson:"returnedAt"`
+	Currency       golang.Currency     `bson:"currency"`
+	CreatedAt      time.Time           `bson:"createdAt"`
+	UpdatedAt      time.Time           `bson:"updatedAt"`
+}
+
+func (l Loan) ToProto() *grpcLoan.Loan {
+	loan := &grpcLoan.Loan{
+		Id:             l.Id.String(),
+		PlayerId:       l.PlayerId.String(),
+		LenderTeamId:   l.LenderTeamId.String(),
+		BorrowerTeamId: l.BorrowerTeamId.String(),
+		Fee:            l.Fee,
+		Status:         l.Status,
+		StartsAt:       timestamppb.New(l.StartsAt),
+		EndsAt:         timestamppb.New(l.EndsAt),
+		Currency:       l.Currency,
+	}
+
+	if l.ReturnedAt != nil {
+		loan.ReturnedAt = timestamppb.New(*l.ReturnedAt)
+	}
+
+	return loan
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"soccer-manager/util/id"
+	"time"
+)
+
+// LoginAttempt counts the failed logins of one email or client ip until ExpiresAt
+type LoginAttempt struct {
+	Key           string    `bson:"_id"`
+	Failures      int32     `bson:"failures"`
+	LastFailureAt time.Time `bson:"lastFailureAt"`
+	ExpiresAt     time.Time `bson:"expiresAt"`
+}
+
+type LoginAuditReason string
+
+const (
+	LoginAuditReasonUnknownEmail  LoginAuditReason = "unknownEmail"
+	LoginAuditReasonWrongPassword LoginAuditReason = "wrongPassword"
+	LoginAuditReasonWrongCode     LoginAuditReason = "wrongCode"
+	LoginAuditReasonLocked        LoginAuditReason = "locked"
+)
+
+// LoginAudit records a failed login, UserId is zero when no user has the email
+type LoginAudit struct {
+	Id          id.LoginAuditID  `bson:"_id"`
+	Email       string           `bson:"email"`
+	UserId      id.UserID        `bson:"userId"`
+	Ip          string           `bson:"ip"`
+	Reason      LoginAuditReason `bson:"reason"`
+	Failures    int32            `bson:"failures"`
+	LockedUntil *time.Time       `bson:"lockedUntil"`
+	ExpiresAt   time.Time        `bson:"expiresAt"`
+	CreatedAt   time.Time        `bson:"createdAt"`
+}
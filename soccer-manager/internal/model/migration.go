@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// Migration marks a one-shot data repair as done, its command does nothing on a second run unless forced
+type Migration struct {
+	Name    string    `bson:"_id"`
+	DoneAt  time.Time `bson:"doneAt"`
+	Summary string    `bson:"summary"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"protobuf-v1/golang"
+	grpcOffer "protobuf-v1/golang/offer"
+	"soccer-manager/util/id"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Offer struct {
+	Id           id.OfferID            `bson:"_id"`
+	PlayerId     id.PlayerID           `bson:"playerId"`
+	BuyerTeamId  id.TeamID             `bson:"buyerTeamId"`
+	SellerTeamId id.TeamID             `bson:"sellerTeamId"`
+	Amount       int64                 `bson:"amount"`
+	HeldAmount   int64                 `bson:"heldAmount"`
+	Status       grpcOffer.OfferStatus `bson:"status"`
+	TransferId   id.TransferID         `bson:"transferId"`
+	ExpiresAt    time.Time             `bson:"expiresAt"`
+	Currency     golang.Currency       `bson:"currency"`
+	CreatedAt    time.Time             `bson:"createdAt"`
+	UpdatedAt    time.Time             `bson:"updatedAt"`
+}
+
+func (o Offer) ToProto() *grpcOffer.Offer {
+	return &grpcOffer.Offer{
+		Id:           o.Id.String(),
+		PlayerId:     o.PlayerId.String(),
+		BuyerTeamId:  o.BuyerTeamId.String(),
+		SellerTeamId: o.SellerTeamId.String(),
+		Amount:       o.Amount,
+		Status:       o.Status,
+		TransferId:   o.TransferId.String(),
+		ExpiresAt:    timestamppb.New(o.ExpiresAt),
+		CreatedAt:    timestamppb.New(o.CreatedAt),
+		UpdatedAt:    timestamppb.New(o.UpdatedAt),
+		Currency:     o.Currency,
+	}
+}
+
+// IsOpen reports whether the offer is still waiting on a response
+func (o Offer) IsOpen() bool {
+	return o.Status == grpcOffer.OfferStatus_OS_PENDING || o.Status == grpcOffer.OfferStatus_OS_COUNTERED
+}
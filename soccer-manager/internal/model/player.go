@@ -6,6 +6,7 @@ import (
 	"soccer-manager/util/id"
 	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -21,19 +22,31 @@ type Player struct {
 	IsListed                  *bool                   `bson:"isListed"`
 	AskValue                  *int64                  `bson:"askValue"`
 	Currency                  golang.Currency         `bson:"currency"`
+	ListingType               grpcPlayer.ListingType  `bson:"listingType"`
+	ReservePrice              *int64                  `bson:"reservePrice"`
+	AuctionEndsAt             *time.Time              `bson:"auctionEndsAt"`
+	HighestBid                *int64                  `bson:"highestBid"`
+	BidCount                  *int32                  `bson:"bidCount"`
+	LoanWeeks                 *int32                  `bson:"loanWeeks"`
+	LoanId                    *id.LoanID              `bson:"loanId"`
+	ParentTeamId              *id.TeamID              `bson:"parentTeamId"`
+	Wage                      *int64                  `bson:"wage"`
+	ContractEndsAt            *time.Time              `bson:"contractEndsAt"`
+	Skills                    *PlayerSkills           `bson:"skills"`
 	CreatedAt                 time.Time               `bson:"createdAt"`
 }
 
 func (p Player) ToProto() *grpcPlayer.Player {
 	player := &grpcPlayer.Player{
-		Id:        p.Id.String(),
-		FirstName: p.FirstName,
-		LastName:  p.LastName,
-		Age:       p.Age,
-		Type:      p.Type,
-		Country:   p.Country,
-		TeamId:    p.TeamId.String(),
-		Currency:  p.Currency,
+		Id:          p.Id.String(),
+		FirstName:   p.FirstName,
+		LastName:    p.LastName,
+		Age:         p.Age,
+		Type:        p.Type,
+		Country:     p.Country,
+		TeamId:      p.TeamId.String(),
+		Currency:    p.Currency,
+		ListingType: p.ListingType,
 	}
 
 	if p.Value != nil {
@@ -48,5 +61,74 @@ func (p Player) ToProto() *grpcPlayer.Player {
 		player.AskValue = &wrapperspb.Int64Value{Value: *p.AskValue}
 	}
 
+	if p.ReservePrice != nil {
+		player.ReservePrice = &wrapperspb.Int64Value{Value: *p.ReservePrice}
+	}
+
+	if p.AuctionEndsAt != nil {
+		player.AuctionEndsAt = timestamppb.New(*p.AuctionEndsAt)
+	}
+
+	if p.HighestBid != nil && p.ListingType == grpcPlayer.ListingType_LT_OPEN_AUCTION {
+		player.HighestBid = &wrapperspb.Int64Value{Value: *p.HighestBid}
+	}
+
+	if p.BidCount != nil {
+		player.BidCount = *p.BidCount
+	}
+
+	if p.LoanWeeks != nil {
+		player.LoanWeeks = *p.LoanWeeks
+	}
+
+	if p.Wage != nil {
+		player.Wage = *p.Wage
+	}
+
+	if p.ContractEndsAt != nil {
+		player.ContractEndsAt = timestamppb.New(*p.ContractEndsAt)
+	}
+
+	if p.Skills != nil {
+		player.Skills = p.Skills.ToProto()
+	}
+
+	if p.IsOnLoan() {
+		player.LoanId = p.LoanId.String()
+		player.ParentTeamId = p.ParentTeamId.String()
+	}
+
 	return player
 }
+
+func (p Player) IsAuction() bool {
+	return p.ListingType == grpcPlayer.ListingType_LT_OPEN_AUCTION || p.ListingType == grpcPlayer.ListingType_LT_SEALED_AUCTION
+}
+
+// IsOnLoan reports whether the player is playing for another team than its parent club
+func (p Player) IsOnLoan() bool {
+	return p.LoanId != nil && !p.LoanId.IsZero()
+}
+
+// PlayerSkills are ratings from 1 to 100, Overall weighs the skills that matter for the player's position
+type PlayerSkills struct {
+	Pace        int32 `bson:"pace"`
+	Shooting    int32 `bson:"shooting"`
+	Passing     int32 `bson:"passing"`
+	Defending   int32 `bson:"defending"`
+	Goalkeeping int32 `bson:"goalkeeping"`
+	Stamina     int32 `bson:"stamina"`
+	Overall     int32 `bson:"overall"`
+}
+
+func (s PlayerSkills) ToProto() *grpcPlayer.PlayerSkills {
+	return &grpcPlayer.PlayerSkills{
+		Pace:        s.Pace,
+		Shooting:    s.Shooting,
+		Passing:     s.Passing,
+		Defending:   s.Defending,
+		Goalkeeping: s.Goalkeeping,
+		Stamina:     s.Stamina,
+		Overall:     s.Overall,
+	}
+}
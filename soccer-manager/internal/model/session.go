@@ -0,0 +1,28 @@
+package model
+
+import (
+	"soccer-manager/util/id"
+	"time"
+)
+
+// RefreshToken is one link of a session's refresh token chain, only the hash of its secret is stored
+type RefreshToken struct {
+	Id         id.RefreshTokenID `bson:"_id"`
+	SessionId  id.SessionID      `bson:"sessionId"`
+	UserId     id.UserID         `bson:"userId"`
+	TeamId     id.TeamID         `bson:"teamId"`
+	SecretHash string            `bson:"secretHash"`
+	ExpiresAt  time.Time         `bson:"expiresAt"`
+	UsedAt     *time.Time        `bson:"usedAt"`
+	RevokedAt  *time.Time        `bson:"revokedAt"`
+	CreatedAt  time.Time         `bson:"createdAt"`
+	UpdatedAt  time.Time         `bson:"updatedAt"`
+}
+
+// RevokedSession is an entry of the revocation list, kept until the session's access tokens expire
+type RevokedSession struct {
+	Id        id.SessionID `bson:"_id"`
+	UserId    id.UserID    `bson:"userId"`
+	ExpiresAt time.Time    `bson:"expiresAt"`
+	CreatedAt time.Time    `bson:"createdAt"`
+}
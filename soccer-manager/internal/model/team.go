@@ -5,6 +5,8 @@ import (
 	grpcTeam "protobuf-v1/golang/team"
 	"soccer-manager/util/id"
 	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Team struct {
@@ -14,7 +16,11 @@ type Team struct {
 	Country                   string                  `bson:"country"`
 	Value                     *int64                  `bson:"value"`
 	Budget                    *int64                  `bson:"budget"`
+	Reserved                  *int64                  `bson:"reserved"`
 	Currency                  golang.Currency         `bson:"currency"`
+	Overdrawn                 *bool                   `bson:"overdrawn"`
+	WageArrears               *int64                  `bson:"wageArrears"`
+	NextPayrollAt             *time.Time              `bson:"nextPayrollAt"`
 	CreatedAt                 time.Time               `bson:"createdAt"`
 }
 
@@ -35,5 +41,38 @@ func (t Team) ToProto() *grpcTeam.Team {
 		team.Budget = *t.Budget
 	}
 
+	if t.Reserved != nil {
+		team.Reserved = *t.Reserved
+	}
+
+	team.Available = t.Available()
+
+	if t.Overdrawn != nil {
+		team.IsOverdrawn = *t.Overdrawn
+	}
+
+	if t.NextPayrollAt != nil {
+		team.NextPayrollAt = timestamppb.New(*t.NextPayrollAt)
+	}
+
+	if t.WageArrears != nil {
+		team.WageArrears = *t.WageArrears
+	}
+
 	return team
 }
+
+// Available is the part of the budget not reserved by holds for pending bids and offers nor owed as wages
+func (t Team) Available() int64 {
+	var available int64
+	if t.Budget != nil {
+		available = *t.Budget
+	}
+	if t.Reserved != nil {
+		available -= *t.Reserved
+	}
+	if t.WageArrears != nil {
+		available -= *t.WageArrears
+	}
+	return available
+}
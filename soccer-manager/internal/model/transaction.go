@@ -7,32 +7,41 @@ import (
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// Transaction is a movement of a team's budget, Budget is the budget after it and nil when it is not known
 type Transaction struct {
 	Id          id.TransactionID        `bson:"_id"`
 	TeamId      id.TeamID               `bson:"teamId"`
 	PlayerId    id.PlayerID             `bson:"playerId"`
+	TransferId  id.TransferID           `bson:"transferId"`
+	LoanId      id.LoanID               `bson:"loanId"`
 	Title       string                  `bson:"title"`
 	Description string                  `bson:"description"`
 	Amount      int64                   `bson:"amount"`
-	Budget      int64                   `bson:"budget"`
+	Budget      *int64                  `bson:"budget"`
 	Type        grpcTxn.TransactionType `bson:"type"`
 	CreatedAt   time.Time               `bson:"createdAt"`
 	Currency    golang.Currency         `bson:"currency"`
 }
 
 func (t Transaction) ToProto() *grpcTxn.Transaction {
-	return &grpcTxn.Transaction{
+	txn := &grpcTxn.Transaction{
 		Id:          t.Id.String(),
 		Title:       t.Title,
 		Description: t.Description,
 		TeamId:      t.TeamId.String(),
 		Amount:      t.Amount,
-		Budget:      t.Budget,
 		PlayerId:    t.PlayerId.String(),
 		Type:        t.Type,
 		CreatedAt:   timestamppb.New(t.CreatedAt),
 		Currency:    t.Currency,
+		TransferId:  t.TransferId.String(),
+		LoanId:      t.LoanId.String(),
 	}
+	if t.Budget != nil {
+		txn.Budget = &wrapperspb.Int64Value{Value: *t.Budget}
+	}
+	return txn
 }
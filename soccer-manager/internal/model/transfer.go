@@ -0,0 +1,32 @@
+package model
+
+import (
+	"protobuf-v1/golang"
+	grpcTransfer "protobuf-v1/golang/transfer"
+	"soccer-manager/util/id"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Transfer struct {
+	Id           id.TransferID   `bson:"_id"`
+	PlayerId     id.PlayerID     `bson:"playerId"`
+	BuyerTeamId  id.TeamID       `bson:"buyerTeamId"`
+	SellerTeamId id.TeamID       `bson:"sellerTeamId"`
+	Amount       int64           `bson:"amount"`
+	CreatedAt    time.Time       `bson:"createdAt"`
+	Currency     golang.Currency `bson:"currency"`
+}
+
+func (t Transfer) ToProto() *grpcTransfer.Transfer {
+	return &grpcTransfer.Transfer{
+		Id:           t.Id.String(),
+		BuyerTeamId:  t.BuyerTeamId.String(),
+		SellerTeamId: t.SellerTeamId.String(),
+		Amount:       t.Amount,
+		PlayerId:     t.PlayerId.String(),
+		CreatedAt:    timestamppb.New(t.CreatedAt),
+		Currency:     t.Currency,
+	}
+}
@@ -9,20 +9,51 @@ import (
 )
 
 type User struct {
-	Id                        id.UserID               `bson:"_id"`
-	TeamId                    id.TeamID               `bson:"teamId"`
-	Name                      string                  `bson:"name"`
-	Email                     string                  `bson:"email"`
-	Password                  string                  `bson:"password"`
-	CreatedAt                 time.Time               `bson:"createdAt"`
+	Id                id.UserID     `bson:"_id"`
+	TeamId            id.TeamID     `bson:"teamId"`
+	Name              string        `bson:"name"`
+	Email             string        `bson:"email"`
+	Password          string        `bson:"password"`
+	EmailVerifiedAt   *time.Time    `bson:"emailVerifiedAt"`
+	PasswordChangedAt *time.Time    `bson:"passwordChangedAt"`
+	Role              grpcUser.Role `bson:"role"`
+	IsFrozen          *bool         `bson:"isFrozen"`
+	FrozenAt          *time.Time    `bson:"frozenAt"`
+	TotpSecret        string        `bson:"totpSecret"`
+	TotpPendingSecret string        `bson:"totpPendingSecret"`
+	TotpEnabledAt     *time.Time    `bson:"totpEnabledAt"`
+	TotpLastCounter   *int64        `bson:"totpLastCounter"`
+	RecoveryCodes     []string      `bson:"recoveryCodes"`
+	CreatedAt         time.Time     `bson:"createdAt"`
 }
 
 func (u User) ToProto() *grpcUser.User {
 	return &grpcUser.User{
-		Id:          u.Id.String(),
-		Name:        u.Name,
-		Email:       u.Email,
-		TeamId:      u.TeamId.String(),
-		CreatedAt:   timestamppb.New(u.CreatedAt),
+		Id:            u.Id.String(),
+		Name:          u.Name,
+		Email:         u.Email,
+		TeamId:        u.TeamId.String(),
+		CreatedAt:     timestamppb.New(u.CreatedAt),
+		EmailVerified: u.EmailVerifiedAt != nil,
+		Role:          u.GetRole(),
+		IsFrozen:      u.Frozen(),
+		TotpEnabled:   u.TotpEnabled(),
 	}
 }
+
+// GetRole returns the role of the user, users created before roles are managers
+func (u User) GetRole() grpcUser.Role {
+	if u.Role == grpcUser.Role_ROLE_UNSPECIFIED {
+		return grpcUser.Role_ROLE_MANAGER
+	}
+	return u.Role
+}
+
+func (u User) Frozen() bool {
+	return u.IsFrozen != nil && *u.IsFrozen
+}
+
+// TotpEnabled tells whether logins need a code of the user's authenticator app besides the password
+func (u User) TotpEnabled() bool {
+	return u.TotpEnabledAt != nil && u.TotpSecret != ""
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"soccer-manager/util/id"
+	"time"
+)
+
+type UserTokenPurpose string
+
+const (
+	UserTokenPurposeResetPassword UserTokenPurpose = "resetPassword"
+	UserTokenPurposeVerifyEmail   UserTokenPurpose = "verifyEmail"
+	UserTokenPurposeLoginTOTP     UserTokenPurpose = "loginTotp"
+)
+
+// UserToken is a single use token mailed to a user or handed out by login, only its hash is stored
+type UserToken struct {
+	Id         id.UserTokenID   `bson:"_id"`
+	UserId     id.UserID        `bson:"userId"`
+	Purpose    UserTokenPurpose `bson:"purpose"`
+	Email      string           `bson:"email"`
+	SecretHash string           `bson:"secretHash"`
+	ExpiresAt  time.Time        `bson:"expiresAt"`
+	UsedAt     *time.Time       `bson:"usedAt"`
+	CreatedAt  time.Time        `bson:"createdAt"`
+	UpdatedAt  time.Time        `bson:"updatedAt"`
+}
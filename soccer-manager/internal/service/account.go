@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"protobuf-v1/golang"
+	grpcLogin "protobuf-v1/golang/login"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"soccer-manager/util/mailer"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// validateNewPassword checks a password a user picks, at register, change or reset
+func validateNewPassword(ctx context.Context, password string, confirmation string) error {
+	if len(password) < 6 {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "password should be at least 6 characters")
+	}
+
+	if password != confirmation {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "password confirmation does not match")
+	}
+	return nil
+}
+
+// ChangePassword sets a new password once the current one is given, every other session of the user is revoked
+func (l login) ChangePassword(ctx context.Context, req *grpcLogin.ChangePasswordRequest) (*grpcLogin.ChangePasswordResponse, error) {
+	claims, err := l.validClaims(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := id.ParseUserID(claims.UserId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+	}
+	sessionId, err := id.ParseSessionID(claims.SessionId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+	}
+
+	user, err := db.NewUserDbManager(l.userCollection).Get(ctx, userId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND, err.Error())
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "current password not matching")
+	}
+
+	if err := validateNewPassword(ctx, req.NewPassword, req.NewPasswordConfirmation); err != nil {
+		return nil, err
+	}
+
+	if err := l.setPassword(ctx, userId, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	if err := l.revokeUserSessions(ctx, userId, sessionId); err != nil {
+		return nil, err
+	}
+	return &grpcLogin.ChangePasswordResponse{}, nil
+}
+
+// ForgotPassword mails a reset token in the background and always gives the same answer
+func (l login) ForgotPassword(ctx context.Context, req *grpcLogin.ForgotPasswordRequest) (*grpcLogin.ForgotPasswordResponse, error) {
+	_, err := mail.ParseAddress(req.Email)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "invalid email")
+	}
+
+	l.asyncWaitGroup.Add(1)
+	go func() {
+		defer l.asyncWaitGroup.Done()
+		l.sendResetPasswordEmail(context.Background(), req.Email)
+	}()
+	return &grpcLogin.ForgotPasswordResponse{}, nil
+}
+
+// sendResetPasswordEmail mails a reset token to the user with the email, failures are logged
+func (l login) sendResetPasswordEmail(ctx context.Context, email string) {
+	user, err := l.getUser(ctx, email)
+	if err != nil {
+		logging.Error("failed to find user for password reset", logging.Fields{"error": err.Error()})
+		return
+	}
+	if user == nil {
+		logging.Info("password reset requested for unknown email")
+		return
+	}
+
+	expirySeconds := config.GetInt64("mail.resetPasswordExpirySeconds")
+	token, err := l.createUserToken(ctx, user, model.UserTokenPurposeResetPassword, expirySeconds)
+	if err != nil {
+		logging.Error("failed to create reset token", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+		return
+	}
+
+	msg := resetPasswordMessage(user.Email, config.GetString("mail.resetPasswordUrl"), token, time.Now().Add(time.Duration(expirySeconds)*time.Second))
+	if err := l.mailer.Send(ctx, msg); err != nil {
+		logging.Error("failed to send reset mail", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+	}
+}
+
+func resetPasswordMessage(to string, baseUrl string, token string, expiresAt time.Time) mailer.Message {
+	return mailer.Message{
+		To:      to,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Someone asked to reset the password of your soccer manager account.\n\n"+
+			"Follow the link below to pick a new one, it works once and expires at %s:\n\n%s\n\n"+
+			"If it was not you, ignore this mail and your password stays the same.",
+			expiresAt.Format(time.RFC1123),
+			tokenLink(baseUrl, token)),
+	}
+}
+
+// ResetPassword sets a new password with a mailed reset token and revokes every session of the user
+func (l login) ResetPassword(ctx context.Context, req *grpcLogin.ResetPasswordRequest) (*grpcLogin.ResetPasswordResponse, error) {
+	if err := validateNewPassword(ctx, req.NewPassword, req.NewPasswordConfirmation); err != nil {
+		return nil, err
+	}
+
+	result, err := runInTransaction(ctx, l.mongoClient, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		token, err := l.checkUserToken(sessionContext, req.Token, model.UserTokenPurposeResetPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := l.setPassword(sessionContext, token.UserId, req.NewPassword); err != nil {
+			return nil, err
+		}
+
+		return l.markUserTokenUsed(sessionContext, token)
+	})
+	if err != nil {
+		return nil, err
+	}
+	token := result.(*model.UserToken)
+
+	if err := l.revokeUserSessions(ctx, token.UserId, id.SessionID{}); err != nil {
+		return nil, err
+	}
+	return &grpcLogin.ResetPasswordResponse{}, nil
+}
+
+// SendVerificationEmail mails the user a new verification token, the ones sent before stop working
+func (l login) SendVerificationEmail(ctx context.Context, req *grpcLogin.SendVerificationEmailRequest) (*grpcLogin.SendVerificationEmailResponse, error) {
+	userId, err := id.ParseUserID(req.UserId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	user, err := db.NewUserDbManager(l.userCollection).Get(ctx, userId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND, err.Error())
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_ALREADY_EXISTS, "email already verified")
+	}
+
+	if err := l.sendVerificationEmail(ctx, user); err != nil {
+		logging.Error("failed to send verification email", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to send mail")
+	}
+	return &grpcLogin.SendVerificationEmailResponse{}, nil
+}
+
+// VerifyEmail marks the email of the user verified with a mailed verification token
+func (l login) VerifyEmail(ctx context.Context, req *grpcLogin.VerifyEmailRequest) (*grpcLogin.VerifyEmailResponse, error) {
+	token, err := l.useUserToken(ctx, req.Token, model.UserTokenPurposeVerifyEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	//the token only verifies the address it was sent to
+	now := time.Now()
+	userFilters := map[string]interface{}{}
+	userFilters["email"] = token.Email
+	_, err = db.NewUserDbManager(l.userCollection).Update(ctx, &model.User{Id: token.UserId, EmailVerifiedAt: &now}, userFilters)
+	if err == mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "email changed since the token was sent")
+	}
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return &grpcLogin.VerifyEmailResponse{}, nil
+}
+
+func (l login) sendVerificationEmail(ctx context.Context, user *model.User) error {
+	token, err := l.createUserToken(ctx, user, model.UserTokenPurposeVerifyEmail, config.GetInt64("mail.verifyEmailExpirySeconds"))
+	if err != nil {
+		return err
+	}
+
+	return l.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Welcome to soccer manager.\n\n"+
+			"Follow the link below to verify your email, it works once and expires at %s:\n\n%s",
+			time.Now().Add(time.Duration(config.GetInt64("mail.verifyEmailExpirySeconds"))*time.Second).Format(time.RFC1123),
+			tokenLink(config.GetString("mail.verifyEmailUrl"), token)),
+	})
+}
+
+func (l login) setPassword(ctx context.Context, userId id.UserID, password string) error {
+	passwordHash, err := util.HashPassword(password)
+	if err != nil {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	now := time.Now()
+	_, err = db.NewUserDbManager(l.userCollection).Update(ctx, &model.User{Id: userId, Password: passwordHash, PasswordChangedAt: &now})
+	if err != nil {
+		logging.Error("failed to update password", logging.Fields{"userId": userId.String(), "error": err.Error()})
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to update password")
+	}
+	return nil
+}
+
+// createUserToken stores a single use token for the user, open tokens of the same purpose are used up
+func (l login) createUserToken(ctx context.Context, user *model.User, purpose model.UserTokenPurpose, expirySeconds int64) (string, error) {
+	tokenId, err := id.NewUserTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	secret, secretHash, err := newTokenSecret()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = runInTransaction(ctx, l.mongoClient, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		if _, err := db.NewUserTokenDbManager(l.userTokenCollection).UseAll(sessionContext, user.Id, purpose, time.Now()); err != nil {
+			return nil, err
+		}
+		return db.NewUserTokenDbManager(l.userTokenCollection).Create(sessionContext, &model.UserToken{
+			Id:         tokenId,
+			UserId:     user.Id,
+			Purpose:    purpose,
+			Email:      user.Email,
+			SecretHash: secretHash,
+			ExpiresAt:  time.Now().Add(time.Duration(expirySeconds) * time.Second),
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+	return tokenId.String() + "." + secret, nil
+}
+
+// useUserToken checks a mailed token and marks it used, a token works once and only for its purpose
+func (l login) useUserToken(ctx context.Context, token string, purpose model.UserTokenPurpose) (*model.UserToken, error) {
+	tokenModel, err := l.checkUserToken(ctx, token, purpose)
+	if err != nil {
+		return nil, err
+	}
+	return l.markUserTokenUsed(ctx, tokenModel)
+}
+
+// checkUserToken returns the token when it is open and has the purpose, without using it
+func (l login) checkUserToken(ctx context.Context, token string, purpose model.UserTokenPurpose) (*model.UserToken, error) {
+	tokenModel, secret, err := l.getUserToken(ctx, token)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+	}
+
+	if tokenModel.Purpose != purpose || !secretMatches(secret, tokenModel.SecretHash) {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "unknown token")
+	}
+	if tokenModel.UsedAt != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "token already used")
+	}
+
+	if !tokenModel.ExpiresAt.After(time.Now()) {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "token expired")
+	}
+	return tokenModel, nil
+}
+
+// markUserTokenUsed uses the token up, it fails when the token was used meanwhile
+func (l login) markUserTokenUsed(ctx context.Context, tokenModel *model.UserToken) (*model.UserToken, error) {
+	now := time.Now()
+	tokenFilters := map[string]interface{}{}
+	tokenFilters["usedAt"] = nil
+	tokenModel, err := db.NewUserTokenDbManager(l.userTokenCollection).Update(ctx, &model.UserToken{Id: tokenModel.Id, UsedAt: &now}, tokenFilters)
+	if err == mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "token already used")
+	}
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return tokenModel, nil
+}
+
+func (l login) getUserToken(ctx context.Context, token string) (*model.UserToken, string, error) {
+	rawId, secret, err := splitSecretToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+	tokenId, err := id.ParseUserTokenID(rawId)
+	if err != nil || tokenId.IsZero() {
+		return nil, "", errors.New("malformed token")
+	}
+
+	tokenModel, err := db.NewUserTokenDbManager(l.userTokenCollection).Get(ctx, tokenId)
+	if err == mongo.ErrNoDocuments {
+		return nil, "", errors.New("unknown token")
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return tokenModel, secret, nil
+}
+
+// tokenLink is the link a mailed token is sent in, the page behind it posts the token to the API
+func tokenLink(baseUrl string, token string) string {
+	return baseUrl + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"soccer-manager/util/mailer"
+	"testing"
+	"time"
+)
+
+var resetLink = regexp.MustCompile(`https://soccer-manager\.local/reset-password\?token=(\S+)`)
+
+func TestResetPasswordMail(t *testing.T) {
+	token := "utk-4c1f0e8a-2f4b-4bb5-9a57-0f6f1c1e2d3a.Zm9v+YmFy/YmF6=="
+	msg := resetPasswordMessage("abc@xyz.com", "https://soccer-manager.local/reset-password", token, time.Now().Add(time.Hour))
+
+	filePath := filepath.Join(t.TempDir(), "mail.log")
+	buf := &bytes.Buffer{}
+	tests := []struct {
+		name   string
+		mailer mailer.Mailer
+		read   func() ([]byte, error)
+	}{
+		{name: "writer", mailer: mailer.NewWriterMailer(buf, "no-reply@soccer-manager.local"), read: func() ([]byte, error) { return buf.Bytes(), nil }},
+		{name: "file", mailer: mailer.NewFileMailer(filePath, "no-reply@soccer-manager.local"), read: func() ([]byte, error) { return ioutil.ReadFile(filePath) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.mailer.Send(context.Background(), msg); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			raw, err := tt.read()
+			if err != nil {
+				t.Fatalf("reading the mail failed, err = %v", err)
+			}
+			if !bytes.Contains(raw, []byte("To: abc@xyz.com\r\n")) {
+				t.Errorf("mail is not addressed to the user:\n%s", raw)
+			}
+
+			match := resetLink.FindSubmatch(raw)
+			if match == nil {
+				t.Fatalf("no reset link in the mail:\n%s", raw)
+			}
+			got, err := url.QueryUnescape(string(match[1]))
+			if err != nil {
+				t.Fatalf("QueryUnescape() error = %v", err)
+			}
+			if got != token {
+				t.Errorf("token in the link = %q, want %q", got, token)
+			}
+			if _, _, err := splitSecretToken(got); err != nil {
+				t.Errorf("splitSecretToken() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"protobuf-v1/golang"
+	grpcLogin "protobuf-v1/golang/login"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CreateApiKey creates a named key with the scopes, the key is only returned here
+func (l login) CreateApiKey(ctx context.Context, req *grpcLogin.CreateApiKeyRequest) (*grpcLogin.CreateApiKeyResponse, error) {
+	user, _, err := l.tokenUser(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > 64 {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "name should be 1 to 64 characters")
+	}
+
+	scopes, err := parseApiKeyScopes(ctx, req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	where := map[string]interface{}{}
+	where["userId"] = user.Id
+	where["revokedAt"] = nil
+	count, err := db.NewApiKeyDbManager(l.apiKeyCollection).Count(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	if count >= config.GetInt64("apiKey.maxPerUser") {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "too many api keys, revoke one first")
+	}
+
+	keyId, err := id.NewApiKeyID()
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	secret, secretHash, err := newTokenSecret()
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	key, err := db.NewApiKeyDbManager(l.apiKeyCollection).Create(ctx, &model.ApiKey{
+		Id:         keyId,
+		UserId:     user.Id,
+		Name:       name,
+		Scopes:     scopes,
+		SecretHash: secretHash,
+	})
+	if err != nil {
+		logging.Error("failed to create api key", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to create api key")
+	}
+
+	return &grpcLogin.CreateApiKeyResponse{
+		ApiKey: key.ToProto(),
+		Key:    keyId.String() + "." + secret,
+	}, nil
+}
+
+// ListApiKeys returns the keys of the user of the access token, revoked ones included
+func (l login) ListApiKeys(ctx context.Context, req *grpcLogin.ListApiKeysRequest) (*grpcLogin.ListApiKeysResponse, error) {
+	user, _, err := l.tokenUser(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	where := map[string]interface{}{}
+	where["userId"] = user.Id
+	keys, err := db.NewApiKeyDbManager(l.apiKeyCollection).Find(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	resp := &grpcLogin.ListApiKeysResponse{}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, key.ToProto())
+	}
+	return resp, nil
+}
+
+// RevokeApiKey revokes a key of the user of the access token, requests with it are refused right away
+func (l login) RevokeApiKey(ctx context.Context, req *grpcLogin.RevokeApiKeyRequest) (*grpcLogin.RevokeApiKeyResponse, error) {
+	user, _, err := l.tokenUser(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	keyId, err := id.ParseApiKeyID(req.Id)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	now := time.Now()
+	keyFilters := map[string]interface{}{}
+	keyFilters["userId"] = user.Id
+	keyFilters["revokedAt"] = nil
+	_, err = db.NewApiKeyDbManager(l.apiKeyCollection).Update(ctx, &model.ApiKey{Id: keyId, RevokedAt: &now}, keyFilters)
+	if err == mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND, "api key not found or already revoked")
+	}
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return &grpcLogin.RevokeApiKeyResponse{}, nil
+}
+
+// revokeUserApiKeys revokes every key of the user, for when the account is frozen or loses its protection
+func (l login) revokeUserApiKeys(ctx context.Context, userId id.UserID) error {
+	if _, err := db.NewApiKeyDbManager(l.apiKeyCollection).RevokeUser(ctx, userId, time.Now()); err != nil {
+		logging.Error("failed to revoke api keys", logging.Fields{"userId": userId.String(), "error": err.Error()})
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to revoke api keys")
+	}
+	return nil
+}
+
+// ValidateApiKey is ValidateJWT for api keys, a key acts as a manager of the user's team
+func (l login) ValidateApiKey(ctx context.Context, req *grpcLogin.ValidateApiKeyRequest) (*grpcLogin.ValidateJWTResponse, error) {
+	rawId, secret, err := splitSecretToken(req.Key)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "malformed api key")
+	}
+	keyId, err := id.ParseApiKeyID(rawId)
+	if err != nil || keyId.IsZero() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "malformed api key")
+	}
+
+	key, err := db.NewApiKeyDbManager(l.apiKeyCollection).Get(ctx, keyId)
+	if err == mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "unknown api key")
+	}
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	if !secretMatches(secret, key.SecretHash) {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "unknown api key")
+	}
+	if key.RevokedAt != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_REVOKED, "api key revoked")
+	}
+
+	user, err := db.NewUserDbManager(l.userCollection).Get(ctx, key.UserId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "user of the api key not found")
+	}
+	if user.Frozen() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_ACCOUNT_FROZEN)
+	}
+
+	l.touchApiKey(ctx, key)
+
+	resp := &grpcLogin.ValidateJWTResponse{
+		UserId:   user.Id.String(),
+		TeamId:   user.TeamId.String(),
+		Role:     string(util.RoleManager),
+		ApiKeyId: key.Id.String(),
+	}
+	for _, scope := range key.Scopes {
+		resp.Scopes = append(resp.Scopes, string(scope))
+	}
+	return resp, nil
+}
+
+// touchApiKey keeps when the key was last used, at most once per apiKey.lastUsedResolutionSeconds
+func (l login) touchApiKey(ctx context.Context, key *model.ApiKey) {
+	now := time.Now()
+	resolution := time.Duration(config.GetInt64("apiKey.lastUsedResolutionSeconds")) * time.Second
+	if key.LastUsedAt != nil && now.Sub(*key.LastUsedAt) < resolution {
+		return
+	}
+
+	_, err := db.NewApiKeyDbManager(l.apiKeyCollection).Update(ctx, &model.ApiKey{Id: key.Id, LastUsedAt: &now})
+	if err != nil {
+		logging.Error("failed to update api key last use", logging.Fields{"apiKeyId": key.Id.String(), "error": err.Error()})
+	}
+}
+
+func parseApiKeyScopes(ctx context.Context, rawScopes []string) ([]util.ApiKeyScope, error) {
+	var scopes []util.ApiKeyScope
+	seen := map[util.ApiKeyScope]bool{}
+	for _, raw := range rawScopes {
+		scope := util.ApiKeyScope(strings.ToLower(strings.TrimSpace(raw)))
+		if !util.ApiKeyScopes[scope] {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "unknown scope "+raw+", scopes are read and trade")
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "at least one scope is required")
+	}
+	return scopes, nil
+}
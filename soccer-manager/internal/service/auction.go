@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"protobuf-v1/golang"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type AuctionSettler interface {
+	SettleExpired(context.Context) error
+}
+
+func NewAuctionSettler(collections Collections, valuation PlayerValuation, mongoClient *mongo.Client) AuctionSettler {
+	return newTransaction(collections, valuation, nil, mongoClient)
+}
+
+func (t transaction) PlaceBid(ctx context.Context, req *grpcTxn.PlaceBidRequest) (*grpcPlayer.Bid, error) {
+
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	teamId, err := id.ParseTeamID(req.TeamId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	if req.Amount <= 0 {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "amount should be greater than 0")
+	}
+
+	if err := t.calendar.checkOpen(ctx); err != nil {
+		return nil, err
+	}
+
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+
+		//checks - auction status, reserve price and current highest bid
+		player, err := db.NewPlayerDbManager(t.playerCollection).Get(sessionContext, playerId)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_NOT_FOUND)
+			}
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if player.IsListed == nil || *player.IsListed == false || !player.IsAuction() {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_AUTH_ERROR, "player is not up for auction")
+		}
+
+		if player.TeamId == teamId {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_AUTH_ERROR, "cannot bid on own player")
+		}
+
+		if player.AuctionEndsAt == nil || !time.Now().Before(*player.AuctionEndsAt) {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "auction has ended")
+		}
+
+		if player.ReservePrice != nil && req.Amount < *player.ReservePrice {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "bid is below the reserve price")
+		}
+
+		if player.ListingType == grpcPlayer.ListingType_LT_OPEN_AUCTION && player.HighestBid != nil && req.Amount <= *player.HighestBid {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "bid should be higher than the current highest bid")
+		}
+
+		where := map[string]interface{}{}
+		where["playerId"] = playerId
+		where["status"] = grpcPlayer.BidStatus_BS_HELD
+		heldBids, err := db.NewBidDbManager(t.bidCollection).Find(sessionContext, where)
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		//release - own previous bid, and in an open auction the bid being outbid
+		var ownBid *model.Bid
+		for _, heldBid := range heldBids {
+			if heldBid.TeamId == teamId {
+				ownBid = heldBid
+			} else if player.ListingType != grpcPlayer.ListingType_LT_OPEN_AUCTION {
+				continue
+			}
+
+			if _, err := t.releaseBudget(sessionContext, heldBid.TeamId, heldBid.Amount); err != nil {
+				return nil, err
+			}
+
+			if heldBid.TeamId != teamId {
+				_, err = db.NewBidDbManager(t.bidCollection).Update(sessionContext, &model.Bid{Id: heldBid.Id, Status: grpcPlayer.BidStatus_BS_OUTBID})
+				if err != nil {
+					return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+				}
+			}
+		}
+
+		//hold - bid amount against the bidding team's budget
+		if _, err := t.holdBudget(sessionContext, teamId, req.Amount); err != nil {
+			return nil, err
+		}
+
+		//update - player bid count (and highest bid for open auctions)
+		var bidCount int32 = 1
+		if player.BidCount != nil {
+			bidCount = *player.BidCount + 1
+		}
+		playerUpdateModel := &model.Player{
+			Id:       player.Id,
+			BidCount: &bidCount,
+		}
+		if player.ListingType == grpcPlayer.ListingType_LT_OPEN_AUCTION {
+			playerUpdateModel.HighestBid = &req.Amount
+		}
+		playerFilters := map[string]interface{}{}
+		playerFilters["isListed"] = true
+		playerFilters["bidCount"] = player.BidCount
+		playerFilters["highestBid"] = player.HighestBid
+
+		_, err = db.NewPlayerDbManager(t.playerCollection).Update(sessionContext, playerUpdateModel, playerFilters)
+		if err != nil {
+			logging.Error("failed to update player", logging.Fields{"playerId": player.Id.String()})
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if ownBid != nil {
+			bid, err := db.NewBidDbManager(t.bidCollection).Update(sessionContext, &model.Bid{Id: ownBid.Id, Amount: req.Amount})
+			if err != nil {
+				return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+			}
+			return bid, nil
+		}
+
+		bidId, err := id.NewBidID()
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		bid, err := db.NewBidDbManager(t.bidCollection).Create(sessionContext, &model.Bid{
+			Id:           bidId,
+			PlayerId:     player.Id,
+			TeamId:       teamId,
+			SellerTeamId: player.TeamId,
+			Amount:       req.Amount,
+			Status:       grpcPlayer.BidStatus_BS_HELD,
+			Currency:     player.Currency,
+		})
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		return bid, nil
+	}
+
+	result, err := runInTransaction(ctx, t.mongoClient, callback)
+	if err != nil {
+		logging.Error("bid transaction failed", logging.Fields{"error": err.Error()})
+		if grpcError.IsGRPCError(err) {
+			return nil, err
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "bid failed due to internal error")
+	}
+
+	bid, ok := result.(*model.Bid)
+	if !ok || bid == nil {
+		logging.Error("transaction returned invalid response")
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "bid failed due to internal error")
+	}
+
+	return bid.ToProto(), nil
+}
+
+// SettleExpired closes every auction whose end time has passed
+func (t transaction) SettleExpired(ctx context.Context) error {
+	where := map[string]interface{}{}
+	where["isListed"] = true
+	where["listingType"] = map[string]interface{}{"$in": []grpcPlayer.ListingType{grpcPlayer.ListingType_LT_OPEN_AUCTION, grpcPlayer.ListingType_LT_SEALED_AUCTION}}
+	where["auctionEndsAt"] = map[string]interface{}{"$lte": time.Now()}
+
+	players, err := db.NewPlayerDbManager(t.playerCollection).Find(ctx, where)
+	if err != nil {
+		return err
+	}
+
+	for _, player := range players {
+		if err := t.settleAuction(ctx, player.Id); err != nil {
+			logging.Error("failed to settle auction", logging.Fields{"playerId": player.Id.String(), "error": err.Error()})
+		}
+	}
+	return nil
+}
+
+// settleAuction sells the player to the highest bid that can be paid and releases the others
+func (t transaction) settleAuction(ctx context.Context, playerId id.PlayerID) error {
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+		player, err := db.NewPlayerDbManager(t.playerCollection).Get(sessionContext, playerId)
+		if err != nil {
+			return nil, err
+		}
+
+		if player.IsListed == nil || *player.IsListed == false || !player.IsAuction() || player.AuctionEndsAt == nil || time.Now().Before(*player.AuctionEndsAt) {
+			return nil, nil
+		}
+
+		where := map[string]interface{}{}
+		where["playerId"] = playerId
+		where["status"] = grpcPlayer.BidStatus_BS_HELD
+		heldBids, err := db.NewBidDbManager(t.bidCollection).Find(sessionContext, where)
+		if err != nil {
+			return nil, err
+		}
+		sortBids(heldBids)
+
+		//winner - highest payable bid meeting the reserve price
+		var winningBid *model.Bid
+		for _, heldBid := range heldBids {
+			if player.ReservePrice != nil && heldBid.Amount < *player.ReservePrice {
+				break
+			}
+			ok, err := t.canCapture(sessionContext, heldBid.TeamId, heldBid.Amount)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				winningBid = heldBid
+				break
+			}
+			logging.Warn("auction bid can not be paid", logging.Fields{"playerId": playerId.String(), "bidId": heldBid.Id.String()})
+		}
+
+		for _, heldBid := range heldBids {
+			status := grpcPlayer.BidStatus_BS_WON
+			if heldBid != winningBid {
+				status = grpcPlayer.BidStatus_BS_LOST
+				if _, err := t.releaseBudget(sessionContext, heldBid.TeamId, heldBid.Amount); err != nil {
+					return nil, err
+				}
+			}
+			_, err = db.NewBidDbManager(t.bidCollection).Update(sessionContext, &model.Bid{Id: heldBid.Id, Status: status})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if winningBid == nil {
+			playerNewListed := false
+			playerFilters := map[string]interface{}{}
+			playerFilters["isListed"] = true
+			playerFilters["bidCount"] = player.BidCount
+			_, err = db.NewPlayerDbManager(t.playerCollection).Update(sessionContext, &model.Player{Id: player.Id, IsListed: &playerNewListed}, playerFilters)
+			return nil, err
+		}
+
+		movePlayerResp, err := t.movePlayer(sessionContext, player, winningBid.TeamId, winningBid.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		transfer, err := t.createTransfer(sessionContext, movePlayerResp.newSrcTeam, movePlayerResp.newDestTeam, movePlayerResp.newPlayer, winningBid.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := t.createHistoryEntry(sessionContext, player, movePlayerResp.newPlayer, transfer); err != nil {
+			return nil, err
+		}
+
+		if err := t.rejectOpenOffers(sessionContext, player.Id); err != nil {
+			return nil, err
+		}
+
+		_, _, err = t.createTransactions(sessionContext, movePlayerResp.newSrcTeam, movePlayerResp.newDestTeam, movePlayerResp.newPlayer, transfer, "Auction", winningBid.Amount)
+		return nil, err
+	}
+
+	_, err := runInTransaction(ctx, t.mongoClient, callback)
+	return err
+}
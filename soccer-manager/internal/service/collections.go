@@ -0,0 +1,42 @@
+package service
+
+import (
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Collections are the mongo collections the services work on
+type Collections struct {
+	User           *mongo.Collection
+	Team           *mongo.Collection
+	Player         *mongo.Collection
+	Transaction    *mongo.Collection
+	Transfer       *mongo.Collection
+	Bid            *mongo.Collection
+	Offer          *mongo.Collection
+	Ledger         *mongo.Collection
+	History        *mongo.Collection
+	Loan           *mongo.Collection
+	RefreshToken   *mongo.Collection
+	RevokedSession *mongo.Collection
+	UserToken      *mongo.Collection
+	ApiKey         *mongo.Collection
+	Migration      *mongo.Collection
+}
+
+// newTransaction builds the transaction service, valuation and calendar may be nil
+func newTransaction(collections Collections, valuation PlayerValuation, calendar TransferCalendar, mongoClient *mongo.Client) transaction {
+	return transaction{
+		txnCollection:       collections.Transaction,
+		playerCollection:    collections.Player,
+		teamCollection:      collections.Team,
+		transferCollection:  collections.Transfer,
+		bidCollection:       collections.Bid,
+		offerCollection:     collections.Offer,
+		ledgerCollection:    collections.Ledger,
+		historyCollection:   collections.History,
+		migrationCollection: collections.Migration,
+		valuation:           valuation,
+		calendar:            calendar,
+		mongoClient:         mongoClient,
+	}
+}
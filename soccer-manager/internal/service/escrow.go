@@ -0,0 +1,102 @@
+package service
+
+import (
+	"protobuf-v1/golang"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Escrow keeps the money of pending bids and offers out of the available budget, inside a mongo transaction
+
+// holdBudget reserves amount from the team's available budget
+func (t transaction) holdBudget(sessionContext mongo.SessionContext, teamId id.TeamID, amount int64) (*model.Team, error) {
+	team, err := t.getEscrowTeam(sessionContext, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if team.Available() < amount {
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "not enough budget")
+	}
+
+	teamNewReserved := reserved(team) + amount
+	return t.updateEscrow(sessionContext, team, &model.Team{Id: teamId, Reserved: &teamNewReserved})
+}
+
+// releaseBudget gives a held amount back to the team's available budget
+func (t transaction) releaseBudget(sessionContext mongo.SessionContext, teamId id.TeamID, amount int64) (*model.Team, error) {
+	team, err := t.getEscrowTeam(sessionContext, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if reserved(team) < amount {
+		logging.Error("releasing more than is reserved", logging.Fields{"teamId": teamId.String()})
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, "release exceeds reserved budget")
+	}
+
+	teamNewReserved := reserved(team) - amount
+	return t.updateEscrow(sessionContext, team, &model.Team{Id: teamId, Reserved: &teamNewReserved})
+}
+
+// captureBudget spends a held amount, taking it out of both the budget and the reserved amount
+func (t transaction) captureBudget(sessionContext mongo.SessionContext, teamId id.TeamID, amount int64) (*model.Team, error) {
+	team, err := t.getEscrowTeam(sessionContext, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if reserved(team) < amount || team.Budget == nil || *team.Budget < amount {
+		logging.Error("capturing more than is reserved", logging.Fields{"teamId": teamId.String()})
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, "capture exceeds reserved budget")
+	}
+
+	teamNewBudget := *team.Budget - amount
+	teamNewReserved := reserved(team) - amount
+	return t.updateEscrow(sessionContext, team, &model.Team{Id: teamId, Budget: &teamNewBudget, Reserved: &teamNewReserved})
+}
+
+// canCapture reports whether the team still holds amount and has the budget to pay it
+func (t transaction) canCapture(sessionContext mongo.SessionContext, teamId id.TeamID, amount int64) (bool, error) {
+	team, err := t.getEscrowTeam(sessionContext, teamId)
+	if err != nil {
+		return false, err
+	}
+	return reserved(team) >= amount && team.Budget != nil && *team.Budget >= amount, nil
+}
+
+func (t transaction) getEscrowTeam(sessionContext mongo.SessionContext, teamId id.TeamID) (*model.Team, error) {
+	team, err := db.NewTeamDbManager(t.teamCollection).Get(sessionContext, teamId)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_NOT_FOUND)
+		}
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return team, nil
+}
+
+func (t transaction) updateEscrow(sessionContext mongo.SessionContext, team *model.Team, updateModel *model.Team) (*model.Team, error) {
+	teamFilters := map[string]interface{}{}
+	teamFilters["budget"] = team.Budget
+	teamFilters["reserved"] = team.Reserved
+
+	newTeam, err := db.NewTeamDbManager(t.teamCollection).Update(sessionContext, updateModel, teamFilters)
+	if err != nil {
+		logging.Error("failed to update team escrow", logging.Fields{"teamId": team.Id.String()})
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return newTeam, nil
+}
+
+func reserved(team *model.Team) int64 {
+	if team.Reserved == nil {
+		return 0
+	}
+	return *team.Reserved
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"protobuf-v1/golang"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// postLedgerEntry records a money movement, it is rejected unless its postings balance
+func postLedgerEntry(ctx context.Context, ledgerCollection *mongo.Collection, transferId id.TransferID, description string, postings ...model.Posting) (*model.LedgerEntry, error) {
+	return postLedgerEntryAt(ctx, ledgerCollection, transferId, description, time.Time{}, postings...)
+}
+
+// postLedgerEntryAt is postLedgerEntry for backfills, the entry is dated at the movement it records
+func postLedgerEntryAt(ctx context.Context, ledgerCollection *mongo.Collection, transferId id.TransferID, description string, at time.Time, postings ...model.Posting) (*model.LedgerEntry, error) {
+	entryId, err := id.NewLedgerEntryID()
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &model.LedgerEntry{
+		Id:          entryId,
+		TransferId:  transferId,
+		Description: description,
+		Postings:    postings,
+		CreatedAt:   at,
+	}
+	if !entry.IsBalanced() {
+		logging.Error("unbalanced ledger entry", logging.Fields{"description": description})
+		return nil, errors.New("ledger entry postings do not balance")
+	}
+
+	return db.NewLedgerDbManager(ledgerCollection).Create(ctx, entry)
+}
+
+func teamBudgetPosting(teamId id.TeamID, amount int64) model.Posting {
+	return model.Posting{Account: grpcTxn.LedgerAccount_LA_TEAM_BUDGET, TeamId: teamId, Amount: amount}
+}
+
+func accountPosting(account grpcTxn.LedgerAccount, amount int64) model.Posting {
+	return model.Posting{Account: account, Amount: amount}
+}
+
+// Reconcile compares the ledger and player values of one or every team with the stored figures
+func (t transaction) Reconcile(ctx context.Context, req *grpcTxn.ReconcileRequest) (*grpcTxn.ReconcileResponse, error) {
+
+	var teams []*model.Team
+	if req.TeamId != "" {
+		teamId, err := id.ParseTeamID(req.TeamId)
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+		}
+
+		team, err := db.NewTeamDbManager(t.teamCollection).Get(ctx, teamId)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+			}
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		teams = append(teams, team)
+	} else {
+		var err error
+		teams, err = db.NewTeamDbManager(t.teamCollection).Find(ctx, map[string]interface{}{})
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+	}
+
+	reconcileResp := &grpcTxn.ReconcileResponse{}
+	for _, team := range teams {
+		teamResp, err := t.reconcileTeam(ctx, team.Id, req.Repair)
+		if err != nil {
+			logging.Error("failed to reconcile team", logging.Fields{"teamId": team.Id.String(), "error": err.Error()})
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		if teamResp.BudgetDrift || teamResp.ValueDrift {
+			reconcileResp.Drifted++
+		}
+		reconcileResp.Teams = append(reconcileResp.Teams, teamResp)
+		reconcileResp.Total++
+	}
+	return reconcileResp, nil
+}
+
+func (t transaction) reconcileTeam(ctx context.Context, teamId id.TeamID, repair bool) (*grpcTxn.TeamReconciliation, error) {
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+		team, err := db.NewTeamDbManager(t.teamCollection).Get(sessionContext, teamId)
+		if err != nil {
+			return nil, err
+		}
+
+		where := map[string]interface{}{}
+		where["postings.teamId"] = teamId
+		entries, err := db.NewLedgerDbManager(t.ledgerCollection).Find(sessionContext, where)
+		if err != nil {
+			return nil, err
+		}
+
+		hasOpeningBalance := false
+		teamResp := &grpcTxn.TeamReconciliation{TeamId: teamId.String()}
+		for _, entry := range entries {
+			teamResp.LedgerBudget += entry.BudgetChange(teamId)
+			for _, posting := range entry.Postings {
+				if posting.Account == grpcTxn.LedgerAccount_LA_BUDGET_GRANTS {
+					hasOpeningBalance = true
+				}
+			}
+		}
+
+		where = map[string]interface{}{}
+		where["teamId"] = teamId
+		players, err := db.NewPlayerDbManager(t.playerCollection).Find(sessionContext, where)
+		if err != nil {
+			return nil, err
+		}
+		//players out on loan still count towards their parent team's value
+		where = map[string]interface{}{}
+		where["parentTeamId"] = teamId
+		loanedPlayers, err := db.NewPlayerDbManager(t.playerCollection).Find(sessionContext, where)
+		if err != nil {
+			return nil, err
+		}
+		for _, player := range append(players, loanedPlayers...) {
+			if player.Value == nil || (player.TeamId == teamId && player.IsOnLoan()) {
+				continue
+			}
+			teamResp.PlayerValue += *player.Value
+		}
+
+		if team.Budget != nil {
+			teamResp.StoredBudget = *team.Budget
+		}
+		if team.Value != nil {
+			teamResp.StoredValue = *team.Value
+		}
+		teamResp.BudgetDrift = teamResp.StoredBudget != teamResp.LedgerBudget
+		teamResp.ValueDrift = teamResp.StoredValue != teamResp.PlayerValue
+		teamResp.MissingOpeningBalance = !hasOpeningBalance
+
+		if !repair || !(teamResp.BudgetDrift || teamResp.ValueDrift) {
+			return teamResp, nil
+		}
+
+		if teamResp.BudgetDrift && teamResp.MissingOpeningBalance {
+			//repair - opening balance for teams that predate the ledger
+			openingBalance := teamResp.StoredBudget - teamResp.LedgerBudget
+			_, err = postLedgerEntry(sessionContext, t.ledgerCollection, id.TransferID{}, "Opening balance",
+				accountPosting(grpcTxn.LedgerAccount_LA_BUDGET_GRANTS, -openingBalance),
+				teamBudgetPosting(teamId, openingBalance),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		teamUpdateModel := &model.Team{Id: teamId}
+		teamFilters := map[string]interface{}{}
+		if teamResp.BudgetDrift && !teamResp.MissingOpeningBalance {
+			teamUpdateModel.Budget = &teamResp.LedgerBudget
+			teamFilters["budget"] = team.Budget
+		}
+		if teamResp.ValueDrift {
+			teamUpdateModel.Value = &teamResp.PlayerValue
+			teamFilters["value"] = team.Value
+		}
+		if len(teamFilters) > 0 {
+			_, err = db.NewTeamDbManager(t.teamCollection).Update(sessionContext, teamUpdateModel, teamFilters)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		logging.Info("repaired team drift", logging.Fields{"teamId": teamId.String(), "budgetDrift": teamResp.BudgetDrift, "valueDrift": teamResp.ValueDrift})
+		teamResp.Repaired = true
+		return teamResp, nil
+	}
+
+	result, err := runInTransaction(ctx, t.mongoClient, callback)
+	if err != nil {
+		return nil, err
+	}
+
+	teamResp, ok := result.(*grpcTxn.TeamReconciliation)
+	if !ok || teamResp == nil {
+		return nil, errors.New("transaction returned invalid response")
+	}
+	return teamResp, nil
+}
@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"protobuf-v1/golang"
+	grpcLoan "protobuf-v1/golang/loan"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const week = 7 * 24 * time.Hour
+
+type loan struct {
+	collection *mongo.Collection
+	txn        transaction
+	grpcLoan.UnimplementedLoanServiceServer
+}
+
+func NewLoanService(collections Collections, calendar TransferCalendar, mongoClient *mongo.Client) grpcLoan.LoanServiceServer {
+	return loan{
+		collection: collections.Loan,
+		txn:        newTransaction(collections, nil, calendar, mongoClient),
+	}
+}
+
+func (l loan) Get(ctx context.Context, req *grpcLoan.GetRequest) (*grpcLoan.Loan, error) {
+
+	loanId, err := id.ParseLoanID(req.Id)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	loanResp, err := db.NewLoanDbManager(l.collection).Get(ctx, loanId)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	return loanResp.ToProto(), nil
+}
+
+// Create borrows a player listed for loan for the listed number of weeks against the loan fee
+func (l loan) Create(ctx context.Context, req *grpcLoan.CreateRequest) (*grpcLoan.Loan, error) {
+
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	borrowerTeamId, err := id.ParseTeamID(req.TeamId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	if err := l.txn.calendar.checkOpen(ctx); err != nil {
+		return nil, err
+	}
+
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+
+		//checks - player listing, ownership and loan status
+		player, err := db.NewPlayerDbManager(l.txn.playerCollection).Get(sessionContext, playerId)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_NOT_FOUND)
+			}
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if player.IsListed == nil || !*player.IsListed || player.ListingType != grpcPlayer.ListingType_LT_LOAN ||
+			player.AskValue == nil || player.LoanWeeks == nil || *player.LoanWeeks <= 0 {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "player is not listed for loan")
+		}
+
+		if player.IsOnLoan() {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "player is on loan")
+		}
+
+		if player.TeamId == borrowerTeamId {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "cannot borrow own player")
+		}
+
+		fee := *player.AskValue
+		loanId, err := id.NewLoanID()
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		//update - player plays for the borrowing team, the lending team is kept as parent team
+		playerNewListed := false
+		lenderTeamId := player.TeamId
+		playerFilters := map[string]interface{}{}
+		playerFilters["isListed"] = player.IsListed
+		playerFilters["askValue"] = player.AskValue
+		playerFilters["teamId"] = player.TeamId
+		newPlayer, err := db.NewPlayerDbManager(l.txn.playerCollection).Update(sessionContext, &model.Player{
+			Id:           player.Id,
+			TeamId:       borrowerTeamId,
+			IsListed:     &playerNewListed,
+			LoanId:       &loanId,
+			ParentTeamId: &lenderTeamId,
+		}, playerFilters)
+		if err != nil {
+			logging.Error("failed to update player", logging.Fields{"playerId": player.Id.String()})
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		//pay - loan fee from the borrowing to the lending team
+		if _, err := l.txn.holdBudget(sessionContext, borrowerTeamId, fee); err != nil {
+			return nil, err
+		}
+		borrowerTeam, err := l.txn.captureBudget(sessionContext, borrowerTeamId, fee)
+		if err != nil {
+			return nil, err
+		}
+
+		lenderTeam, err := l.txn.getEscrowTeam(sessionContext, lenderTeamId)
+		if err != nil {
+			return nil, err
+		}
+		lenderTeamNewBudget := *lenderTeam.Budget + fee
+		lenderTeamFilters := map[string]interface{}{}
+		lenderTeamFilters["budget"] = lenderTeam.Budget
+		lenderTeam, err = db.NewTeamDbManager(l.txn.teamCollection).Update(sessionContext, &model.Team{Id: lenderTeamId, Budget: &lenderTeamNewBudget}, lenderTeamFilters)
+		if err != nil {
+			logging.Error("failed to update lender team", logging.Fields{"teamId": lenderTeamId.String()})
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		startsAt := time.Now()
+		loanModel, err := db.NewLoanDbManager(l.collection).Create(sessionContext, &model.Loan{
+			Id:             loanId,
+			PlayerId:       player.Id,
+			LenderTeamId:   lenderTeamId,
+			BorrowerTeamId: borrowerTeamId,
+			Fee:            fee,
+			Status:         grpcLoan.LoanStatus_LS_ACTIVE,
+			StartsAt:       startsAt,
+			EndsAt:         startsAt.Add(time.Duration(*player.LoanWeeks) * week),
+			Currency:       borrowerTeam.Currency,
+		})
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if err := l.createTransactions(sessionContext, lenderTeam, borrowerTeam, newPlayer, loanModel); err != nil {
+			return nil, err
+		}
+
+		if err := l.createHistoryEntry(sessionContext, grpcPlayer.PlayerHistoryType_PHT_LOAN, newPlayer, loanModel, lenderTeamId, borrowerTeamId, fee); err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		return loanModel, nil
+	}
+
+	result, err := runInTransaction(ctx, l.txn.mongoClient, callback)
+	if err != nil {
+		logging.Error("loan transaction failed", logging.Fields{"error": err.Error()})
+		if grpcError.IsGRPCError(err) {
+			return nil, err
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "loan failed due to internal error")
+	}
+
+	loanResp, ok := result.(*model.Loan)
+	if !ok || loanResp == nil {
+		logging.Error("transaction returned invalid response")
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "loan failed due to internal error")
+	}
+
+	return loanResp.ToProto(), nil
+}
+
+func (l loan) GetBorrowed(ctx context.Context, req *grpcLoan.GetByTeamRequest) (*grpcLoan.Loans, error) {
+	return l.getByTeam(ctx, req.TeamId, "borrowerTeamId")
+}
+
+func (l loan) GetLent(ctx context.Context, req *grpcLoan.GetByTeamRequest) (*grpcLoan.Loans, error) {
+	return l.getByTeam(ctx, req.TeamId, "lenderTeamId")
+}
+
+func (l loan) getByTeam(ctx context.Context, reqTeamId string, teamField string) (*grpcLoan.Loans, error) {
+
+	teamId, err := id.ParseTeamID(reqTeamId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	where := map[string]interface{}{}
+	where[teamField] = teamId
+	loanResp, err := db.NewLoanDbManager(l.collection).Find(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	sort.SliceStable(loanResp, func(i, j int) bool {
+		return loanResp[i].CreatedAt.After(loanResp[j].CreatedAt)
+	})
+
+	loansResp := &grpcLoan.Loans{}
+	for _, loanModel := range loanResp {
+		loansResp.Loans = append(loansResp.Loans, loanModel.ToProto())
+		loansResp.Total++
+	}
+	return loansResp, nil
+}
+
+// createTransactions records the loan fee in both teams' transactions and in the ledger
+func (l loan) createTransactions(ctx context.Context, lenderTeam *model.Team, borrowerTeam *model.Team, newPlayer *model.Player, loanModel *model.Loan) error {
+	_, err := l.txn.createTransaction(ctx, &createTransactionRequest{
+		teamModel:   lenderTeam,
+		amount:      loanModel.Fee,
+		txnType:     grpcTxn.TransactionType_TT_LOAN_OUT,
+		playerModel: newPlayer,
+		loan:        loanModel,
+		description: "Loan",
+	})
+	if err != nil {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	// post ledger entry - the loan fee moves from the borrowing to the lending team's budget
+	_, err = postLedgerEntry(ctx, l.txn.ledgerCollection, id.TransferID{}, "Loan",
+		teamBudgetPosting(borrowerTeam.Id, -loanModel.Fee),
+		teamBudgetPosting(lenderTeam.Id, loanModel.Fee),
+	)
+	if err != nil {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	_, err = l.txn.createTransaction(ctx, &createTransactionRequest{
+		teamModel:   borrowerTeam,
+		amount:      loanModel.Fee,
+		txnType:     grpcTxn.TransactionType_TT_LOAN_IN,
+		playerModel: newPlayer,
+		loan:        loanModel,
+		description: "Loan",
+	})
+	if err != nil {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return nil
+}
+
+type LoanReturner interface {
+	ReturnExpired(context.Context) error
+}
+
+func NewLoanReturner(collections Collections, mongoClient *mongo.Client) LoanReturner {
+	return loan{
+		collection: collections.Loan,
+		txn:        newTransaction(collections, nil, nil, mongoClient),
+	}
+}
+
+// ReturnExpired sends every player whose loan has ended back to its parent team
+func (l loan) ReturnExpired(ctx context.Context) error {
+	where := map[string]interface{}{}
+	where["status"] = grpcLoan.LoanStatus_LS_ACTIVE
+	where["endsAt"] = map[string]interface{}{"$lte": time.Now()}
+
+	loans, err := db.NewLoanDbManager(l.collection).Find(ctx, where)
+	if err != nil {
+		return err
+	}
+
+	for _, loanModel := range loans {
+		if err := l.returnPlayer(ctx, loanModel); err != nil {
+			logging.Error("failed to return loaned player", logging.Fields{"loanId": loanModel.Id.String(), "error": err.Error()})
+		}
+	}
+	return nil
+}
+
+func (l loan) returnPlayer(ctx context.Context, loanModel *model.Loan) error {
+	_, err := runInTransaction(ctx, l.txn.mongoClient, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		//update - player back to the parent team, the loan fields are cleared
+		playerFilters := map[string]interface{}{}
+		playerFilters["loanId"] = loanModel.Id
+		playerFilters["teamId"] = loanModel.BorrowerTeamId
+		newPlayer, err := db.NewPlayerDbManager(l.txn.playerCollection).Update(sessionContext, &model.Player{
+			Id:           loanModel.PlayerId,
+			TeamId:       loanModel.LenderTeamId,
+			LoanId:       &id.LoanID{},
+			ParentTeamId: &id.TeamID{},
+		}, playerFilters)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := l.createHistoryEntry(sessionContext, grpcPlayer.PlayerHistoryType_PHT_LOAN_RETURN, newPlayer, loanModel, loanModel.BorrowerTeamId, loanModel.LenderTeamId, 0); err != nil {
+			return nil, err
+		}
+
+		returnedAt := time.Now()
+		loanFilters := map[string]interface{}{}
+		loanFilters["status"] = grpcLoan.LoanStatus_LS_ACTIVE
+		return db.NewLoanDbManager(l.collection).Update(sessionContext, &model.Loan{
+			Id:         loanModel.Id,
+			Status:     grpcLoan.LoanStatus_LS_RETURNED,
+			ReturnedAt: &returnedAt,
+		}, loanFilters)
+	})
+	return err
+}
+
+// createHistoryEntry records the player going out on loan or coming back from it
+func (l loan) createHistoryEntry(ctx context.Context, historyType grpcPlayer.PlayerHistoryType, player *model.Player, loanModel *model.Loan, fromTeamId id.TeamID, toTeamId id.TeamID, fee int64) error {
+	historyId, err := id.NewPlayerHistoryID()
+	if err != nil {
+		return err
+	}
+	var value int64
+	if player.Value != nil {
+		value = *player.Value
+	}
+	_, err = db.NewPlayerHistoryDbManager(l.txn.historyCollection).Create(ctx, &model.PlayerHistoryEntry{
+		Id:         historyId,
+		PlayerId:   player.Id,
+		Type:       historyType,
+		FromTeamId: fromTeamId,
+		ToTeamId:   toTeamId,
+		LoanId:     loanModel.Id,
+		Fee:        fee,
+		OldValue:   value,
+		NewValue:   value,
+		Currency:   loanModel.Currency,
+	})
+	return err
+}
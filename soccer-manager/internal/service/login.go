@@ -7,6 +7,8 @@ import (
 	"protobuf-v1/golang"
 	grpcLogin "protobuf-v1/golang/login"
 	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	grpcUser "protobuf-v1/golang/user"
 	"soccer-manager/internal/db"
 	"soccer-manager/internal/model"
 	"soccer-manager/util"
@@ -14,29 +16,55 @@ import (
 	grpcError "soccer-manager/util/error"
 	"soccer-manager/util/id"
 	"soccer-manager/util/jwt"
+	"soccer-manager/util/logging"
+	"soccer-manager/util/mailer"
+	"soccer-manager/util/names"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/crypto/bcrypt"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type login struct {
-	userCollection   *mongo.Collection
-	playerCollection *mongo.Collection
-	teamCollection   *mongo.Collection
-	mongoClient      *mongo.Client
-	asyncWaitGroup   AsyncWaitGroup
+	userCollection           *mongo.Collection
+	playerCollection         *mongo.Collection
+	teamCollection           *mongo.Collection
+	ledgerCollection         *mongo.Collection
+	historyCollection        *mongo.Collection
+	transferCollection       *mongo.Collection
+	txnCollection            *mongo.Collection
+	refreshTokenCollection   *mongo.Collection
+	revokedSessionCollection *mongo.Collection
+	userTokenCollection      *mongo.Collection
+	apiKeyCollection         *mongo.Collection
+	loginAttempts            db.LoginAttemptStore
+	names                    *names.Generator
+	keys                     *jwt.KeySet
+	mailer                   mailer.Mailer
+	asyncWaitGroup           AsyncWaitGroup
+	mongoClient              *mongo.Client
 	grpcLogin.UnimplementedLoginServiceServer
 }
 
-func NewLoginService(userCollection *mongo.Collection, playerCollection *mongo.Collection, teamCollection *mongo.Collection, mongoClient *mongo.Client, asyncWaitGroup AsyncWaitGroup) grpcLogin.LoginServiceServer {
+func NewLoginService(collections Collections, loginAttempts db.LoginAttemptStore, nameGenerator *names.Generator, keySet *jwt.KeySet, mailSender mailer.Mailer, asyncWaitGroup AsyncWaitGroup, mongoClient *mongo.Client) grpcLogin.LoginServiceServer {
 	return login{
-		userCollection:   userCollection,
-		teamCollection:   teamCollection,
-		playerCollection: playerCollection,
-		mongoClient:      mongoClient,
-		asyncWaitGroup:   asyncWaitGroup,
+		userCollection:           collections.User,
+		teamCollection:           collections.Team,
+		playerCollection:         collections.Player,
+		ledgerCollection:         collections.Ledger,
+		historyCollection:        collections.History,
+		transferCollection:       collections.Transfer,
+		txnCollection:            collections.Transaction,
+		refreshTokenCollection:   collections.RefreshToken,
+		revokedSessionCollection: collections.RevokedSession,
+		userTokenCollection:      collections.UserToken,
+		apiKeyCollection:         collections.ApiKey,
+		loginAttempts:            loginAttempts,
+		names:                    nameGenerator,
+		keys:                     keySet,
+		mailer:                   mailSender,
+		asyncWaitGroup:           asyncWaitGroup,
+		mongoClient:              mongoClient,
 	}
 }
 
@@ -50,64 +78,75 @@ func (l login) Login(ctx context.Context, req *grpcLogin.LoginRequest) (*grpcLog
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "password should be at least 6 characters")
 	}
 
-	user, err := l.getUser(ctx, req)
+	attemptKeys := loginAttemptKeys(req.Email, req.Ip)
+	reservation, err := l.reserveLoginAttempt(ctx, attemptKeys, req.Email, req.Ip)
 	if err != nil {
 		return nil, err
 	}
 
+	user, err := l.getUser(ctx, req.Email)
+	if err != nil {
+		l.releaseLoginAttempt(ctx, reservation)
+		return nil, err
+	}
+
 	if user == nil {
-		user, err = l.createUser(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+		l.loginFailed(ctx, reservation, req.Email, req.Ip, id.UserID{}, model.LoginAuditReasonUnknownEmail)
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND, "no user with this email, register first")
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		l.loginFailed(ctx, reservation, req.Email, req.Ip, user.Id, model.LoginAuditReasonWrongPassword)
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "user exists; password not matching")
 	}
+	l.releaseLoginAttempt(ctx, reservation)
 
-	tokenExpirationSeconds := config.GetInt32("jwt.expirationSeconds")
-	token, err := jwt.GenerateToken(ctx, config.GetString("JWT_KEY"), user.Id, user.TeamId, tokenExpirationSeconds)
-	if err != nil {
-		return nil, err
+	if user.Frozen() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_ACCOUNT_FROZEN)
+	}
+
+	//the failed logins are only cleared after the second factor
+	if user.TotpEnabled() {
+		return l.totpChallenge(ctx, user)
 	}
+	l.loginSucceeded(ctx, attemptKeys)
 
-	return &grpcLogin.LoginResponse{
-		AccessToken:   token,
-		TokenType:     "Bearer",
-		UserId:        user.Id.String(),
-		TokenValidity: timestamppb.New(time.Now().Add(time.Second * time.Duration(tokenExpirationSeconds))),
-	}, nil
+	return l.newSession(ctx, user)
 }
 
-func (l login) ValidateJWT(ctx context.Context, req *grpcLogin.ValidateJWTRequest) (*grpcLogin.ValidateJWTResponse, error) {
-	if req.Jwt == "" {
-		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "token missing")
+// Register creates a user with its team and squad and logs it in
+func (l login) Register(ctx context.Context, req *grpcLogin.RegisterRequest) (*grpcLogin.LoginResponse, error) {
+	_, err := mail.ParseAddress(req.Email)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "invalid email")
 	}
 
-	claims := &jwt.Claims{}
-	err := jwt.ParseToken(ctx, req.Jwt, claims, config.GetString("JWT_KEY"))
+	if err := validateNewPassword(ctx, req.Password, req.PasswordConfirmation); err != nil {
+		return nil, err
+	}
+
+	user, err := l.createUser(ctx, req.Email, req.Password)
 	if err != nil {
 		return nil, err
 	}
-	return &grpcLogin.ValidateJWTResponse{
-		UserId: claims.UserId,
-		TeamId: claims.TeamId,
-	}, nil
+
+	//the account works without a verified email, a failed mail is sent again on request
+	if err := l.sendVerificationEmail(ctx, user); err != nil {
+		logging.Error("failed to send verification email", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+	}
+
+	return l.newSession(ctx, user)
 }
 
-func (l login) getUser(ctx context.Context, req *grpcLogin.LoginRequest) (*model.User, error) {
+// getUser returns the user with the email, nil when there is none. Emails are unique, see db.CreateUserIndexes
+func (l login) getUser(ctx context.Context, email string) (*model.User, error) {
 	where := map[string]interface{}{}
-	where["email"] = req.Email
+	where["email"] = email
 	userResp, err := db.NewUserDbManager(l.userCollection).Find(ctx, where)
 	if err != nil {
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to find users")
 	}
 
-	if len(userResp) > 1 {
-		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "multiple users exists with this email")
-	}
-
 	if len(userResp) == 0 {
 		return nil, nil
 	}
@@ -115,7 +154,7 @@ func (l login) getUser(ctx context.Context, req *grpcLogin.LoginRequest) (*model
 	return userResp[0], nil
 }
 
-func (l login) createUser(ctx context.Context, req *grpcLogin.LoginRequest) (*model.User, error) {
+func (l login) createUser(ctx context.Context, email string, password string) (*model.User, error) {
 	userID, err := id.NewUserID()
 	if err != nil {
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
@@ -126,7 +165,7 @@ func (l login) createUser(ctx context.Context, req *grpcLogin.LoginRequest) (*mo
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
 	}
 
-	password, err := util.HashPassword(req.Password)
+	passwordHash, err := util.HashPassword(password)
 	if err != nil {
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
 	}
@@ -134,70 +173,75 @@ func (l login) createUser(ctx context.Context, req *grpcLogin.LoginRequest) (*mo
 	user := &model.User{
 		Id:       userID,
 		TeamId:   teamID,
-		Email:    req.Email,
-		Password: password,
+		Email:    email,
+		Password: passwordHash,
+		Role:     grpcUser.Role_ROLE_MANAGER,
 	}
-	userResp, err := db.NewUserDbManager(l.userCollection).Create(ctx, user)
+	//create - user, team and squad together, a user never exists without its team
+	result, err := runInTransaction(ctx, l.mongoClient, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		userResp, err := db.NewUserDbManager(l.userCollection).Create(sessionContext, user)
+		if err != nil {
+			return nil, err
+		}
+		if err := l.createTeam(sessionContext, userID, teamID); err != nil {
+			return nil, err
+		}
+		return userResp, nil
+	})
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_ALREADY_EXISTS, "a user with this email already exists")
+		}
+		logging.Error("failed to create user", logging.Fields{"error": err.Error()})
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to create user")
 	}
 
-	l.asyncWaitGroup.Add(1)
-	go l.createTeam(ctx, userID, teamID)
+	userResp, ok := result.(*model.User)
+	if !ok || userResp == nil {
+		logging.Error("transaction returned invalid response")
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to create user")
+	}
 	return userResp, nil
 }
 
-func (l login) createTeam(ctx context.Context, userID id.UserID, teamID id.TeamID) error {
-	defer l.asyncWaitGroup.Done()
-
-	//create team
+// createTeam creates the team with its initial budget and a full squad, it must run inside a mongo transaction
+func (l login) createTeam(sessionContext mongo.SessionContext, userID id.UserID, teamID id.TeamID) error {
 	teamValue := config.GetInt64("team.value")
 	teamBudget := config.GetInt64("team.budget")
+	teamNextPayrollAt := time.Now().Add(payrollPeriod())
 	teamModel := &model.Team{
-		Id:       teamID,
-		UserId:   userID,
-		Value:    &teamValue,
-		Budget:   &teamBudget,
-		Currency: golang.Currency_CURRENCY_USD,
+		Id:            teamID,
+		UserId:        userID,
+		Value:         &teamValue,
+		Budget:        &teamBudget,
+		Currency:      golang.Currency_CURRENCY_USD,
+		NextPayrollAt: &teamNextPayrollAt,
 	}
-	_, err := db.NewTeamDbManager(l.teamCollection).Create(ctx, teamModel)
+	_, err := db.NewTeamDbManager(l.teamCollection).Create(sessionContext, teamModel)
 	if err != nil {
 		return err
 	}
 
-	//create players
-	err = l.createTeamPlayers(ctx, teamID)
+	//post ledger entry - initial budget grant
+	_, err = postLedgerEntry(sessionContext, l.ledgerCollection, id.TransferID{}, "Initial budget",
+		accountPosting(grpcTxn.LedgerAccount_LA_BUDGET_GRANTS, -teamBudget),
+		teamBudgetPosting(teamID, teamBudget),
+	)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	//create players
+	return l.createTeamPlayers(sessionContext, teamID, squadSize())
 }
 
-func (l login) createTeamPlayers(ctx context.Context, teamID id.TeamID) error {
-	var err error
-	for i := 1; i <= config.GetInt("team.goalKeepers"); i++ {
-		err = l.createPlayer(ctx, teamID, grpcPlayer.PlayerType_PT_GOAL_KEEPER)
-		if err != nil {
-			return err
-		}
-	}
-	for i := 1; i <= config.GetInt("team.defenders"); i++ {
-		err = l.createPlayer(ctx, teamID, grpcPlayer.PlayerType_PT_DEFENDER)
-		if err != nil {
-			return err
-		}
-	}
-	for i := 1; i <= config.GetInt("team.midFielders"); i++ {
-		err = l.createPlayer(ctx, teamID, grpcPlayer.PlayerType_PT_MID_FIELDER)
-		if err != nil {
-			return err
-		}
-	}
-	for i := 1; i <= config.GetInt("team.attackers"); i++ {
-		err = l.createPlayer(ctx, teamID, grpcPlayer.PlayerType_PT_ATTACKER)
-		if err != nil {
-			return err
+// createTeamPlayers creates the given number of players for each position
+func (l login) createTeamPlayers(ctx context.Context, teamID id.TeamID, squad map[grpcPlayer.PlayerType]int) error {
+	for _, playerType := range squadPositions {
+		for i := 1; i <= squad[playerType]; i++ {
+			if err := l.createPlayer(ctx, teamID, playerType); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -212,14 +256,38 @@ func (l login) createPlayer(ctx context.Context, teamID id.TeamID, playerType gr
 	if err != nil {
 		return err
 	}
+	playerWage, playerContractEndsAt := newContract(playerValue)
+	person := l.names.Person()
 	playerModel := &model.Player{
-		Id:       playerId,
-		TeamId:   teamID,
-		Age:      rand.Int31n(playerMaxAge-playerMinAge) + playerMinAge,
-		Value:    &playerValue,
-		Type:     playerType,
-		Currency: golang.Currency_CURRENCY_USD,
+		Id:             playerId,
+		TeamId:         teamID,
+		FirstName:      person.FirstName,
+		LastName:       person.LastName,
+		Country:        person.Country,
+		Age:            rand.Int31n(playerMaxAge-playerMinAge) + playerMinAge,
+		Value:          &playerValue,
+		Type:           playerType,
+		Currency:       golang.Currency_CURRENCY_USD,
+		Wage:           &playerWage,
+		ContractEndsAt: &playerContractEndsAt,
+		Skills:         newPlayerSkills(playerType),
 	}
 	_, err = db.NewPlayerDbManager(l.playerCollection).Create(ctx, playerModel)
+	if err != nil {
+		return err
+	}
+
+	historyId, err := id.NewPlayerHistoryID()
+	if err != nil {
+		return err
+	}
+	_, err = db.NewPlayerHistoryDbManager(l.historyCollection).Create(ctx, &model.PlayerHistoryEntry{
+		Id:       historyId,
+		PlayerId: playerId,
+		Type:     grpcPlayer.PlayerHistoryType_PHT_JOINED,
+		ToTeamId: teamID,
+		NewValue: playerValue,
+		Currency: playerModel.Currency,
+	})
 	return err
 }
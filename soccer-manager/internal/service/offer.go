@@ -0,0 +1,491 @@
+package service
+
+import (
+	"context"
+	"protobuf-v1/golang"
+	grpcOffer "protobuf-v1/golang/offer"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type offer struct {
+	collection *mongo.Collection
+	txn        transaction
+	grpcOffer.UnimplementedOfferServiceServer
+}
+
+func NewOfferService(collections Collections, valuation PlayerValuation, calendar TransferCalendar, mongoClient *mongo.Client) grpcOffer.OfferServiceServer {
+	return offer{
+		collection: collections.Offer,
+		txn:        newTransaction(collections, valuation, calendar, mongoClient),
+	}
+}
+
+func (o offer) Get(ctx context.Context, req *grpcOffer.GetRequest) (*grpcOffer.Offer, error) {
+
+	offerId, err := id.ParseOfferID(req.Id)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	offerResp, err := o.get(ctx, offerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return offerResp.ToProto(), nil
+}
+
+func (o offer) Create(ctx context.Context, req *grpcOffer.CreateRequest) (*grpcOffer.Offer, error) {
+
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	teamId, err := id.ParseTeamID(req.TeamId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	if req.Amount <= 0 {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "amount should be greater than 0")
+	}
+
+	if err := o.txn.calendar.checkOpen(ctx); err != nil {
+		return nil, err
+	}
+
+	//checks - player ownership, auction status and team budget
+	player, err := db.NewPlayerDbManager(o.txn.playerCollection).Get(ctx, playerId)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	if player.TeamId == teamId {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "cannot make an offer for own player")
+	}
+
+	if player.IsListed != nil && *player.IsListed && player.IsAuction() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "player is listed for auction, place a bid instead")
+	}
+
+	if player.IsOnLoan() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "player is on loan")
+	}
+
+	team, err := db.NewTeamDbManager(o.txn.teamCollection).Get(ctx, teamId)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	if team.Available() < req.Amount {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "not enough budget")
+	}
+
+	//expire - stale open offers of the team for the player
+	where := openOfferFilters()
+	where["playerId"] = playerId
+	where["buyerTeamId"] = teamId
+	where["expiresAt"] = map[string]interface{}{"$lte": time.Now()}
+	staleOffers, err := db.NewOfferDbManager(o.collection).Find(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	for _, staleOffer := range staleOffers {
+		if _, err := o.expireIfStale(ctx, staleOffer); err != nil {
+			return nil, err
+		}
+	}
+
+	offerId, err := id.NewOfferID()
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+		//checks - no open offer of the team for the player
+		where := openOfferFilters()
+		where["playerId"] = playerId
+		where["buyerTeamId"] = teamId
+		where["expiresAt"] = map[string]interface{}{"$gt": time.Now()}
+		openOffers, err := db.NewOfferDbManager(o.collection).Find(sessionContext, where)
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		if len(openOffers) > 0 {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "an open offer for this player already exists")
+		}
+
+		//hold - offered amount from the buying team until the offer is closed
+		if _, err := o.txn.holdBudget(sessionContext, teamId, req.Amount); err != nil {
+			return nil, err
+		}
+
+		offerResp, err := db.NewOfferDbManager(o.collection).Create(sessionContext, &model.Offer{
+			Id:           offerId,
+			PlayerId:     player.Id,
+			BuyerTeamId:  teamId,
+			SellerTeamId: player.TeamId,
+			Amount:       req.Amount,
+			HeldAmount:   req.Amount,
+			Status:       grpcOffer.OfferStatus_OS_PENDING,
+			ExpiresAt:    getOfferExpiry(),
+			Currency:     team.Currency,
+		})
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		return offerResp, nil
+	}
+
+	offerResp, err := o.runInTransaction(ctx, callback, "offer failed due to internal error")
+	if err != nil {
+		return nil, err
+	}
+
+	return offerResp.ToProto(), nil
+}
+
+// Respond applies the action of the team whose turn it is to an open offer
+func (o offer) Respond(ctx context.Context, req *grpcOffer.RespondRequest) (*grpcOffer.Offer, error) {
+
+	offerId, err := id.ParseOfferID(req.Id)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	teamId, err := id.ParseTeamID(req.TeamId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	oldOffer, err := o.get(ctx, offerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if oldOffer.Status == grpcOffer.OfferStatus_OS_EXPIRED {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "offer has expired")
+	}
+
+	if !oldOffer.IsOpen() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "offer is no longer open")
+	}
+
+	respondingTeamId := oldOffer.SellerTeamId
+	nextStatus := grpcOffer.OfferStatus_OS_COUNTERED
+	if oldOffer.Status == grpcOffer.OfferStatus_OS_COUNTERED {
+		respondingTeamId = oldOffer.BuyerTeamId
+		nextStatus = grpcOffer.OfferStatus_OS_PENDING
+	}
+
+	var offerResp *model.Offer
+
+	//access check
+	if teamId != respondingTeamId {
+		if teamId != oldOffer.BuyerTeamId || req.Action != grpcOffer.OfferAction_OA_REJECT {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR)
+		}
+		offerResp, err = o.update(ctx, oldOffer, &model.Offer{Id: oldOffer.Id, Status: grpcOffer.OfferStatus_OS_WITHDRAWN})
+		if err != nil {
+			return nil, err
+		}
+		return offerResp.ToProto(), nil
+	}
+
+	//check - only rejecting is allowed outside a transfer window
+	if req.Action != grpcOffer.OfferAction_OA_REJECT {
+		if err := o.txn.calendar.checkOpen(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	switch req.Action {
+	case grpcOffer.OfferAction_OA_ACCEPT:
+		offerResp, err = o.accept(ctx, oldOffer)
+	case grpcOffer.OfferAction_OA_REJECT:
+		offerResp, err = o.update(ctx, oldOffer, &model.Offer{Id: oldOffer.Id, Status: grpcOffer.OfferStatus_OS_REJECTED})
+	case grpcOffer.OfferAction_OA_COUNTER:
+		if req.Amount <= 0 {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "amount should be greater than 0")
+		}
+		if req.Amount == oldOffer.Amount {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "counter offer should change the amount")
+		}
+		updateModel := &model.Offer{Id: oldOffer.Id, Status: nextStatus, Amount: req.Amount, ExpiresAt: getOfferExpiry()}
+		if teamId == oldOffer.BuyerTeamId {
+			updateModel.HeldAmount = req.Amount
+		}
+		offerResp, err = o.update(ctx, oldOffer, updateModel)
+	default:
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "invalid action")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return offerResp.ToProto(), nil
+}
+
+func (o offer) GetIncoming(ctx context.Context, req *grpcOffer.GetByTeamRequest) (*grpcOffer.Offers, error) {
+	return o.getByTeam(ctx, req.TeamId, "sellerTeamId")
+}
+
+func (o offer) GetOutgoing(ctx context.Context, req *grpcOffer.GetByTeamRequest) (*grpcOffer.Offers, error) {
+	return o.getByTeam(ctx, req.TeamId, "buyerTeamId")
+}
+
+func (o offer) getByTeam(ctx context.Context, reqTeamId string, teamField string) (*grpcOffer.Offers, error) {
+
+	teamId, err := id.ParseTeamID(reqTeamId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	where := map[string]interface{}{}
+	where[teamField] = teamId
+	offerResp, err := db.NewOfferDbManager(o.collection).Find(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	sort.SliceStable(offerResp, func(i, j int) bool {
+		return offerResp[i].CreatedAt.After(offerResp[j].CreatedAt)
+	})
+
+	offersResp := &grpcOffer.Offers{}
+	for _, offerModel := range offerResp {
+		offerModel, err = o.expireIfStale(ctx, offerModel)
+		if err != nil {
+			return nil, err
+		}
+		offersResp.Offers = append(offersResp.Offers, offerModel.ToProto())
+		offersResp.Total++
+	}
+	return offersResp, nil
+}
+
+// accept executes the transfer at the offered amount and closes the offer
+func (o offer) accept(ctx context.Context, oldOffer *model.Offer) (*model.Offer, error) {
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+
+		//checks - player ownership, auction status and team budget
+		player, err := db.NewPlayerDbManager(o.txn.playerCollection).Get(sessionContext, oldOffer.PlayerId)
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if player.TeamId != oldOffer.SellerTeamId {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "player no longer belongs to the selling team")
+		}
+
+		if player.IsListed != nil && *player.IsListed && player.IsAuction() {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "player is listed for auction")
+		}
+
+		//hold - a counter offer accepted by the buyer is paid from a hold of the countered amount
+		if oldOffer.HeldAmount != oldOffer.Amount {
+			if err := o.rehold(sessionContext, oldOffer, oldOffer.Amount); err != nil {
+				return nil, err
+			}
+		}
+
+		movePlayerResp, err := o.txn.movePlayer(sessionContext, player, oldOffer.BuyerTeamId, oldOffer.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		transfer, err := o.txn.createTransfer(sessionContext, movePlayerResp.newSrcTeam, movePlayerResp.newDestTeam, movePlayerResp.newPlayer, oldOffer.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := o.txn.createHistoryEntry(sessionContext, player, movePlayerResp.newPlayer, transfer); err != nil {
+			return nil, err
+		}
+
+		_, _, err = o.txn.createTransactions(sessionContext, movePlayerResp.newSrcTeam, movePlayerResp.newDestTeam, movePlayerResp.newPlayer, transfer, "Offer", oldOffer.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		offerFilters := map[string]interface{}{}
+		offerFilters["status"] = oldOffer.Status
+		offerFilters["amount"] = oldOffer.Amount
+		newOffer, err := db.NewOfferDbManager(o.collection).Update(sessionContext, &model.Offer{Id: oldOffer.Id, Status: grpcOffer.OfferStatus_OS_ACCEPTED, TransferId: transfer.Id}, offerFilters)
+		if err != nil {
+			logging.Error("failed to update offer", logging.Fields{"offerId": oldOffer.Id.String()})
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if err := o.txn.rejectOpenOffers(sessionContext, oldOffer.PlayerId); err != nil {
+			return nil, err
+		}
+
+		return newOffer, nil
+	}
+
+	return o.runInTransaction(ctx, callback, "accept failed due to internal error")
+}
+
+// update writes updateModel if the offer is still unanswered and moves the buyer's hold with it
+func (o offer) update(ctx context.Context, oldOffer *model.Offer, updateModel *model.Offer) (*model.Offer, error) {
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+		offerFilters := map[string]interface{}{}
+		offerFilters["status"] = oldOffer.Status
+		offerFilters["amount"] = oldOffer.Amount
+
+		newOffer, err := db.NewOfferDbManager(o.collection).Update(sessionContext, updateModel, offerFilters)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "offer has changed, please retry")
+			}
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if !newOffer.IsOpen() {
+			if _, err := o.txn.releaseBudget(sessionContext, oldOffer.BuyerTeamId, oldOffer.HeldAmount); err != nil {
+				return nil, err
+			}
+		} else if updateModel.HeldAmount != 0 && updateModel.HeldAmount != oldOffer.HeldAmount {
+			if err := o.rehold(sessionContext, oldOffer, updateModel.HeldAmount); err != nil {
+				return nil, err
+			}
+		}
+		return newOffer, nil
+	}
+
+	return o.runInTransaction(ctx, callback, "offer update failed due to internal error")
+}
+
+// rehold replaces the buyer's hold for the offer with a hold of amount
+func (o offer) rehold(sessionContext mongo.SessionContext, oldOffer *model.Offer, amount int64) error {
+	if _, err := o.txn.releaseBudget(sessionContext, oldOffer.BuyerTeamId, oldOffer.HeldAmount); err != nil {
+		return err
+	}
+	_, err := o.txn.holdBudget(sessionContext, oldOffer.BuyerTeamId, amount)
+	return err
+}
+
+func (o offer) runInTransaction(ctx context.Context, callback func(mongo.SessionContext) (interface{}, error), internalErrMsg string) (*model.Offer, error) {
+	result, err := runInTransaction(ctx, o.txn.mongoClient, callback)
+	if err != nil {
+		logging.Error("offer transaction failed", logging.Fields{"error": err.Error()})
+		if grpcError.IsGRPCError(err) {
+			return nil, err
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, internalErrMsg)
+	}
+
+	newOffer, ok := result.(*model.Offer)
+	if !ok || newOffer == nil {
+		logging.Error("transaction returned invalid response")
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, internalErrMsg)
+	}
+
+	return newOffer, nil
+}
+
+func (o offer) get(ctx context.Context, offerId id.OfferID) (*model.Offer, error) {
+	offerResp, err := db.NewOfferDbManager(o.collection).Get(ctx, offerId)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return o.expireIfStale(ctx, offerResp)
+}
+
+// expireIfStale expires an open offer past its expiry and releases its hold
+func (o offer) expireIfStale(ctx context.Context, offerModel *model.Offer) (*model.Offer, error) {
+	if !offerModel.IsOpen() || time.Now().Before(offerModel.ExpiresAt) {
+		return offerModel, nil
+	}
+
+	newOffer, err := o.update(ctx, offerModel, &model.Offer{Id: offerModel.Id, Status: grpcOffer.OfferStatus_OS_EXPIRED})
+	if err != nil {
+		// the offer may have been answered in the meantime
+		newOffer, err = db.NewOfferDbManager(o.collection).Get(ctx, offerModel.Id)
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+	}
+	return newOffer, nil
+}
+
+type OfferExpirer interface {
+	ExpireStale(context.Context) error
+}
+
+func NewOfferExpirer(collections Collections, mongoClient *mongo.Client) OfferExpirer {
+	return offer{
+		collection: collections.Offer,
+		txn:        newTransaction(collections, nil, nil, mongoClient),
+	}
+}
+
+// ExpireStale expires every open offer past its expiry and releases the buyer's hold
+func (o offer) ExpireStale(ctx context.Context) error {
+	where := openOfferFilters()
+	where["expiresAt"] = map[string]interface{}{"$lte": time.Now()}
+	staleOffers, err := db.NewOfferDbManager(o.collection).Find(ctx, where)
+	if err != nil {
+		return err
+	}
+
+	for _, staleOffer := range staleOffers {
+		if _, err := o.expireIfStale(ctx, staleOffer); err != nil {
+			logging.Error("failed to expire offer", logging.Fields{"offerId": staleOffer.Id.String(), "error": err.Error()})
+		}
+	}
+	return nil
+}
+
+// rejectOpenOffers rejects the open offers for a sold player, inside the mongo transaction of the sale
+func (t transaction) rejectOpenOffers(sessionContext mongo.SessionContext, playerId id.PlayerID) error {
+	where := openOfferFilters()
+	where["playerId"] = playerId
+	openOffers, err := db.NewOfferDbManager(t.offerCollection).Find(sessionContext, where)
+	if err != nil {
+		return grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	for _, openOffer := range openOffers {
+		offerFilters := map[string]interface{}{}
+		offerFilters["status"] = openOffer.Status
+		_, err = db.NewOfferDbManager(t.offerCollection).Update(sessionContext, &model.Offer{Id: openOffer.Id, Status: grpcOffer.OfferStatus_OS_REJECTED}, offerFilters)
+		if err != nil {
+			return grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		if _, err := t.releaseBudget(sessionContext, openOffer.BuyerTeamId, openOffer.HeldAmount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func openOfferFilters() map[string]interface{} {
+	where := map[string]interface{}{}
+	where["status"] = map[string]interface{}{"$in": []grpcOffer.OfferStatus{grpcOffer.OfferStatus_OS_PENDING, grpcOffer.OfferStatus_OS_COUNTERED}}
+	return where
+}
+
+func getOfferExpiry() time.Time {
+	return time.Now().Add(time.Second * time.Duration(config.GetInt64("offer.expirySeconds")))
+}
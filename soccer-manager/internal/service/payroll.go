@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/config"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// newContract returns the weekly wage and the end of a new contract for a player worth value
+func newContract(value int64) (int64, time.Time) {
+	minWeeks := config.GetInt32("contract.minWeeks")
+	maxWeeks := config.GetInt32("contract.maxWeeks")
+	weeks := minWeeks
+	if maxWeeks > minWeeks {
+		weeks += rand.Int31n(maxWeeks - minWeeks + 1)
+	}
+	wage := int64(math.Round(float64(value) * config.GetFloat64("contract.wageRate")))
+	return wage, time.Now().Add(time.Duration(weeks) * week)
+}
+
+// payrollPeriod is the time between two wage payments of a team
+func payrollPeriod() time.Duration {
+	return time.Duration(config.GetInt64("payroll.periodSeconds")) * time.Second
+}
+
+type Payroll interface {
+	PayWages(context.Context) error
+}
+
+func NewPayroll(collections Collections, mongoClient *mongo.Client) Payroll {
+	return newTransaction(collections, nil, nil, mongoClient)
+}
+
+// PayWages debits the wages of their players from every team whose payroll is due
+func (t transaction) PayWages(ctx context.Context) error {
+	where := map[string]interface{}{}
+	where["$or"] = []map[string]interface{}{
+		{"nextPayrollAt": map[string]interface{}{"$lte": time.Now()}},
+		{"nextPayrollAt": nil},
+	}
+
+	teams, err := db.NewTeamDbManager(t.teamCollection).Find(ctx, where)
+	if err != nil {
+		return err
+	}
+
+	for _, team := range teams {
+		if err := t.payTeam(ctx, team.Id); err != nil {
+			logging.Error("failed to pay wages", logging.Fields{"teamId": team.Id.String(), "error": err.Error()})
+		}
+	}
+	return nil
+}
+
+// payTeam pays the team's due payroll periods from its available budget, the rest is owed as arrears
+func (t transaction) payTeam(ctx context.Context, teamId id.TeamID) error {
+	_, err := runInTransaction(ctx, t.mongoClient, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		now := time.Now()
+		team, err := db.NewTeamDbManager(t.teamCollection).Get(sessionContext, teamId)
+		if err != nil {
+			return nil, err
+		}
+
+		if team.NextPayrollAt == nil {
+			nextPayrollAt := now.Add(payrollPeriod())
+			return db.NewTeamDbManager(t.teamCollection).Update(sessionContext, &model.Team{Id: teamId, NextPayrollAt: &nextPayrollAt})
+		}
+		if team.NextPayrollAt.After(now) {
+			return team, nil
+		}
+
+		//periods missed while the service was down are all paid
+		periods := int64(now.Sub(*team.NextPayrollAt)/payrollPeriod()) + 1
+		nextPayrollAt := team.NextPayrollAt.Add(time.Duration(periods) * payrollPeriod())
+
+		weeklyWages, err := t.getWages(sessionContext, teamId, now)
+		if err != nil {
+			return nil, err
+		}
+		wages := periodWages(weeklyWages, periods)
+
+		//pay - arrears and wages (up to the available budget)
+		var teamArrears int64
+		if team.WageArrears != nil {
+			teamArrears = *team.WageArrears
+		}
+		due := teamArrears + wages
+		paid := due
+		if payable := team.Available() + teamArrears; paid > payable {
+			paid = int64(math.Max(float64(payable), 0))
+		}
+
+		//update - team budget and arrears (check budget and payroll date)
+		var teamBudget int64
+		if team.Budget != nil {
+			teamBudget = *team.Budget
+		}
+		teamNewBudget := teamBudget - paid
+		teamNewArrears := due - paid
+		teamOverdrawn := teamNewArrears > 0
+		teamFilters := map[string]interface{}{}
+		teamFilters["budget"] = team.Budget
+		teamFilters["nextPayrollAt"] = team.NextPayrollAt
+		newTeam, err := db.NewTeamDbManager(t.teamCollection).Update(sessionContext, &model.Team{
+			Id:            teamId,
+			Budget:        &teamNewBudget,
+			Overdrawn:     &teamOverdrawn,
+			WageArrears:   &teamNewArrears,
+			NextPayrollAt: &nextPayrollAt,
+		}, teamFilters)
+		if err != nil {
+			return nil, err
+		}
+
+		if paid > 0 {
+			// post ledger entry - the wages leave the team's budget
+			_, err = postLedgerEntry(sessionContext, t.ledgerCollection, id.TransferID{}, "Wages",
+				teamBudgetPosting(teamId, -paid),
+				accountPosting(grpcTxn.LedgerAccount_LA_WAGES, paid),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = t.createTransaction(sessionContext, &createTransactionRequest{
+				teamModel:   newTeam,
+				amount:      paid,
+				txnType:     grpcTxn.TransactionType_TT_WAGE,
+				description: "Wages",
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if teamOverdrawn {
+			logging.Warn("team owes wages after payroll", logging.Fields{"teamId": teamId.String(), "arrears": teamNewArrears})
+		}
+		return newTeam, nil
+	})
+	return err
+}
+
+// periodWages is what the weekly wages come to over the given number of payroll periods
+func periodWages(weeklyWages int64, periods int64) int64 {
+	return int64(math.Round(float64(weeklyWages) * float64(periods) * float64(payrollPeriod()) / float64(week)))
+}
+
+// getWages adds up the weekly wages of the team's players, renewing and recording contracts that ran out
+func (t transaction) getWages(sessionContext mongo.SessionContext, teamId id.TeamID, now time.Time) (int64, error) {
+	where := map[string]interface{}{}
+	where["teamId"] = teamId
+	players, err := db.NewPlayerDbManager(t.playerCollection).Find(sessionContext, where)
+	if err != nil {
+		return 0, err
+	}
+
+	var wages int64
+	for _, player := range players {
+		if player.Wage == nil || player.ContractEndsAt == nil || !player.ContractEndsAt.After(now) {
+			var value int64
+			if player.Value != nil {
+				value = *player.Value
+			}
+			var oldWage int64
+			if player.Wage != nil {
+				oldWage = *player.Wage
+			}
+			playerNewWage, playerNewContractEndsAt := newContract(value)
+			player, err = db.NewPlayerDbManager(t.playerCollection).Update(sessionContext, &model.Player{
+				Id:             player.Id,
+				Wage:           &playerNewWage,
+				ContractEndsAt: &playerNewContractEndsAt,
+			})
+			if err != nil {
+				return 0, err
+			}
+
+			historyId, err := id.NewPlayerHistoryID()
+			if err != nil {
+				return 0, err
+			}
+			_, err = db.NewPlayerHistoryDbManager(t.historyCollection).Create(sessionContext, &model.PlayerHistoryEntry{
+				Id:       historyId,
+				PlayerId: player.Id,
+				Type:     grpcPlayer.PlayerHistoryType_PHT_CONTRACT_RENEWAL,
+				ToTeamId: teamId,
+				OldValue: value,
+				NewValue: value,
+				OldWage:  oldWage,
+				NewWage:  playerNewWage,
+				Currency: player.Currency,
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+		wages += *player.Wage
+	}
+	return wages, nil
+}
@@ -4,22 +4,36 @@ import (
 	"context"
 	"protobuf-v1/golang"
 	grpcPlayer "protobuf-v1/golang/player"
+	"regexp"
 	"soccer-manager/internal/db"
 	"soccer-manager/internal/model"
+	"soccer-manager/util/config"
 	grpcError "soccer-manager/util/error"
 	"soccer-manager/util/id"
+	"sort"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type player struct {
-	collection *mongo.Collection
+	collection        *mongo.Collection
+	bidCollection     *mongo.Collection
+	historyCollection *mongo.Collection
+	calendar          TransferCalendar
+	mongoClient       *mongo.Client
 	grpcPlayer.UnimplementedPlayerServiceServer
 }
 
-func NewPlayerService(collection *mongo.Collection) grpcPlayer.PlayerServiceServer {
+func NewPlayerService(collections Collections, calendar TransferCalendar, mongoClient *mongo.Client) grpcPlayer.PlayerServiceServer {
 	return player{
-		collection: collection,
+		collection:        collections.Player,
+		bidCollection:     collections.Bid,
+		historyCollection: collections.History,
+		calendar:          calendar,
+		mongoClient:       mongoClient,
 	}
 }
 
@@ -51,6 +65,10 @@ func (p player) GetByTeam(ctx context.Context, req *grpcPlayer.GetByTeamRequest)
 	where := map[string]interface{}{}
 	where["teamId"] = teamId
 
+	if err := addSkillFilters(ctx, where, req.Skills); err != nil {
+		return nil, err
+	}
+
 	playerResp, err := db.NewPlayerDbManager(p.collection).Find(ctx, where)
 	if err != nil {
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
@@ -69,19 +87,135 @@ func (p player) GetListed(ctx context.Context, req *grpcPlayer.GetListedRequest)
 	where := map[string]interface{}{}
 	where["isListed"] = true
 
-	playerResp, err := db.NewPlayerDbManager(p.collection).Find(ctx, where)
+	if req.Type != grpcPlayer.PlayerType_PT_UNSPECIFIED {
+		where["type"] = req.Type
+	}
+
+	if req.Country != "" {
+		where["country"] = req.Country
+	}
+
+	if req.TeamId != "" {
+		teamId, err := id.ParseTeamID(req.TeamId)
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+		}
+		where["teamId"] = teamId
+	}
+
+	if req.Name != "" {
+		name := primitive.Regex{Pattern: regexp.QuoteMeta(req.Name), Options: "i"}
+		where["$or"] = []map[string]interface{}{{"firstName": name}, {"lastName": name}}
+	}
+
+	if req.MinAge != nil && req.MaxAge != nil && req.MinAge.Value > req.MaxAge.Value {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "min age can not be greater than max age")
+	}
+	ageRange := map[string]interface{}{}
+	if req.MinAge != nil {
+		ageRange["$gte"] = req.MinAge.Value
+	}
+	if req.MaxAge != nil {
+		ageRange["$lte"] = req.MaxAge.Value
+	}
+	if len(ageRange) > 0 {
+		where["age"] = ageRange
+	}
+
+	if req.MinAskValue != nil && req.MaxAskValue != nil && req.MinAskValue.Value > req.MaxAskValue.Value {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "min ask value can not be greater than max ask value")
+	}
+	askValueRange := map[string]interface{}{}
+	if req.MinAskValue != nil {
+		askValueRange["$gte"] = req.MinAskValue.Value
+	}
+	if req.MaxAskValue != nil {
+		askValueRange["$lte"] = req.MaxAskValue.Value
+	}
+	if len(askValueRange) > 0 {
+		where["askValue"] = askValueRange
+	}
+
+	if err := addSkillFilters(ctx, where, req.Skills); err != nil {
+		return nil, err
+	}
+
+	if req.PageSize < 0 {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "page size can not be negative")
+	}
+
+	page := db.Page{
+		Filters:    where,
+		SortField:  "askValue",
+		Descending: req.SortOrder == golang.SortOrder_SORT_ORDER_DESC,
+		Limit:      int64(req.PageSize),
+		Cursor:     req.Cursor,
+	}
+	switch req.SortBy {
+	case grpcPlayer.PlayerSortField_PSF_VALUE:
+		page.SortField = "value"
+	case grpcPlayer.PlayerSortField_PSF_AGE:
+		page.SortField = "age"
+	case grpcPlayer.PlayerSortField_PSF_OVERALL:
+		page.SortField = "skills.overall"
+	}
+
+	playerResp, nextCursor, err := db.NewPlayerDbManager(p.collection).FindPage(ctx, page)
 	if err != nil {
+		if err == db.ErrInvalidCursor {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, err.Error())
+		}
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
 	}
 
-	playersResp := &grpcPlayer.Players{}
+	total, err := db.NewPlayerDbManager(p.collection).Count(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	playersResp := &grpcPlayer.Players{Total: int32(total), NextCursor: nextCursor}
 	for _, player := range playerResp {
 		playersResp.Players = append(playersResp.Players, player.ToProto())
-		playersResp.Total++
 	}
 	return playersResp, nil
 }
 
+// addSkillFilters adds the overall rating range and the minimum skills of the filter to where
+func addSkillFilters(ctx context.Context, where map[string]interface{}, filter *grpcPlayer.SkillFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	if filter.MinOverall != nil && filter.MaxOverall != nil && filter.MinOverall.Value > filter.MaxOverall.Value {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "min overall can not be greater than max overall")
+	}
+	overallRange := map[string]interface{}{}
+	if filter.MinOverall != nil {
+		overallRange["$gte"] = filter.MinOverall.Value
+	}
+	if filter.MaxOverall != nil {
+		overallRange["$lte"] = filter.MaxOverall.Value
+	}
+	if len(overallRange) > 0 {
+		where["skills.overall"] = overallRange
+	}
+
+	minSkills := map[string]*wrapperspb.Int32Value{
+		"skills.pace":        filter.MinPace,
+		"skills.shooting":    filter.MinShooting,
+		"skills.passing":     filter.MinPassing,
+		"skills.defending":   filter.MinDefending,
+		"skills.goalkeeping": filter.MinGoalkeeping,
+		"skills.stamina":     filter.MinStamina,
+	}
+	for field, min := range minSkills {
+		if min != nil {
+			where[field] = map[string]interface{}{"$gte": min.Value}
+		}
+	}
+	return nil
+}
+
 func (p player) Update(ctx context.Context, req *grpcPlayer.UpdateRequest) (*grpcPlayer.Player, error) {
 
 	playerId, err := id.ParsePlayerID(req.Id)
@@ -93,20 +227,105 @@ func (p player) Update(ctx context.Context, req *grpcPlayer.UpdateRequest) (*grp
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "playerId can not be blank")
 	}
 
-	player, err := p.Get(ctx, &grpcPlayer.GetRequest{Id: playerId.String()})
+	playerModel, err := db.NewPlayerDbManager(p.collection).Get(ctx, playerId)
 	if err != nil {
-		return nil, err
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	player := playerModel.ToProto()
+
+	listingType := req.ListingType
+	if listingType == grpcPlayer.ListingType_LT_UNSPECIFIED {
+		listingType = player.ListingType
 	}
+	isAuction := listingType == grpcPlayer.ListingType_LT_OPEN_AUCTION || listingType == grpcPlayer.ListingType_LT_SEALED_AUCTION
 
-	if req.IsListed != nil && req.IsListed.GetValue() && req.AskValue == nil && player.AskValue == nil {
+	listingChanged := req.IsListed != nil || req.ListingType != grpcPlayer.ListingType_LT_UNSPECIFIED || req.AskValue != nil || req.ReservePrice != nil || req.AuctionEndsAt != nil || req.LoanWeeks != nil
+	if listingChanged && player.LoanId != "" {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "player is on loan")
+	}
+
+	if listingChanged && player.IsListed && (player.ListingType == grpcPlayer.ListingType_LT_OPEN_AUCTION || player.ListingType == grpcPlayer.ListingType_LT_SEALED_AUCTION) {
+		where := map[string]interface{}{}
+		where["playerId"] = playerId
+		where["status"] = grpcPlayer.BidStatus_BS_HELD
+		heldBids, err := db.NewBidDbManager(p.bidCollection).Find(ctx, where)
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		if len(heldBids) > 0 {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "auction has active bids")
+		}
+	}
+
+	//a bid placed after the check fails the update
+	playerFilters := map[string]interface{}{}
+	if listingChanged {
+		playerFilters["bidCount"] = playerModel.BidCount
+		playerFilters["highestBid"] = playerModel.HighestBid
+	}
+
+	if req.IsListed != nil && req.IsListed.GetValue() {
+		if err := p.calendar.checkOpen(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.IsListed != nil && req.IsListed.GetValue() && !isAuction && req.AskValue == nil && player.AskValue == nil {
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "askValue can not be blank")
 	}
 
 	updateModel := &model.Player{
-		Id:        playerId,
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Country:   req.Country,
+		Id:          playerId,
+		FirstName:   req.FirstName,
+		LastName:    req.LastName,
+		Country:     req.Country,
+		ListingType: req.ListingType,
+	}
+
+	if req.IsListed != nil && req.IsListed.GetValue() && isAuction {
+		if req.ReservePrice == nil && player.ReservePrice == nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "reservePrice can not be blank")
+		}
+		if req.ReservePrice != nil && req.ReservePrice.GetValue() <= 0 {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "reservePrice should be greater than 0")
+		}
+		if req.AuctionEndsAt == nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "auctionEndsAt can not be blank")
+		}
+
+		auctionEndsAt := req.AuctionEndsAt.AsTime()
+		minEndsAt := time.Now().Add(time.Second * time.Duration(config.GetInt64("auction.minDurationSeconds")))
+		maxEndsAt := time.Now().Add(time.Second * time.Duration(config.GetInt64("auction.maxDurationSeconds")))
+		if auctionEndsAt.Before(minEndsAt) || auctionEndsAt.After(maxEndsAt) {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "auctionEndsAt is outside the allowed auction duration")
+		}
+		//auctions are not unlisted when the window closes, so they have to end inside it
+		if err := p.calendar.checkClosesAfter(ctx, auctionEndsAt); err != nil {
+			return nil, err
+		}
+
+		var highestBid int64
+		var bidCount int32
+		updateModel.AuctionEndsAt = &auctionEndsAt
+		updateModel.HighestBid = &highestBid
+		updateModel.BidCount = &bidCount
+		if req.ReservePrice != nil {
+			updateModel.ReservePrice = &req.ReservePrice.Value
+		}
+	}
+
+	if req.IsListed != nil && req.IsListed.GetValue() && listingType == grpcPlayer.ListingType_LT_LOAN && req.LoanWeeks == nil && player.LoanWeeks == 0 {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "loanWeeks can not be blank")
+	}
+
+	if req.LoanWeeks != nil {
+		if req.LoanWeeks.GetValue() < config.GetInt32("loan.minWeeks") || req.LoanWeeks.GetValue() > config.GetInt32("loan.maxWeeks") {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "loanWeeks is outside the allowed loan duration")
+		}
+		updateModel.LoanWeeks = &req.LoanWeeks.Value
 	}
 
 	if req.AskValue != nil {
@@ -124,8 +343,15 @@ func (p player) Update(ctx context.Context, req *grpcPlayer.UpdateRequest) (*grp
 		updateModel.Value = &req.Value.Value
 	}
 
-	playerResp, err := db.NewPlayerDbManager(p.collection).Update(ctx, updateModel)
+	if req.Value != nil && req.Value.Value != player.Value {
+		return p.updateValue(ctx, updateModel, player.Value, playerFilters)
+	}
+
+	playerResp, err := db.NewPlayerDbManager(p.collection).Update(ctx, updateModel, playerFilters)
 	if err != nil {
+		if err == mongo.ErrNoDocuments && listingChanged {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "auction has active bids")
+		}
 		if err == mongo.ErrNoDocuments {
 			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
 		}
@@ -134,3 +360,115 @@ func (p player) Update(ctx context.Context, req *grpcPlayer.UpdateRequest) (*grp
 
 	return playerResp.ToProto(), nil
 }
+
+// updateValue sets the value while it is still the old one and records the change in the player's history
+func (p player) updateValue(ctx context.Context, updateModel *model.Player, oldValue int64, playerFilters map[string]interface{}) (*grpcPlayer.Player, error) {
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+		playerFilters["value"] = oldValue
+		playerResp, err := db.NewPlayerDbManager(p.collection).Update(sessionContext, updateModel, playerFilters)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_NOT_FOUND)
+			}
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		historyId, err := id.NewPlayerHistoryID()
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		_, err = db.NewPlayerHistoryDbManager(p.historyCollection).Create(sessionContext, &model.PlayerHistoryEntry{
+			Id:       historyId,
+			PlayerId: playerResp.Id,
+			Type:     grpcPlayer.PlayerHistoryType_PHT_VALUE_CHANGE,
+			ToTeamId: playerResp.TeamId,
+			OldValue: oldValue,
+			NewValue: *playerResp.Value,
+			Currency: playerResp.Currency,
+		})
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		return playerResp, nil
+	}
+
+	result, err := runInTransaction(ctx, p.mongoClient, callback)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*model.Player).ToProto(), nil
+}
+
+func (p player) GetBids(ctx context.Context, req *grpcPlayer.GetBidsRequest) (*grpcPlayer.Bids, error) {
+
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	where := map[string]interface{}{}
+	where["playerId"] = playerId
+
+	if req.TeamId != "" {
+		teamId, err := id.ParseTeamID(req.TeamId)
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+		}
+		where["teamId"] = teamId
+	}
+
+	bidResp, err := db.NewBidDbManager(p.bidCollection).Find(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	sortBids(bidResp)
+
+	bidsResp := &grpcPlayer.Bids{}
+	for _, bid := range bidResp {
+		bidsResp.Bids = append(bidsResp.Bids, bid.ToProto())
+		bidsResp.Total++
+	}
+	return bidsResp, nil
+}
+
+// sortBids orders bids by amount, highest first; equal bids are ordered by who bid first
+func sortBids(bids []*model.Bid) {
+	sort.SliceStable(bids, func(i, j int) bool {
+		if bids[i].Amount != bids[j].Amount {
+			return bids[i].Amount > bids[j].Amount
+		}
+		return bids[i].CreatedAt.Before(bids[j].CreatedAt)
+	})
+}
+
+// GetHistory returns every club the player joined, oldest first
+func (p player) GetHistory(ctx context.Context, req *grpcPlayer.GetHistoryRequest) (*grpcPlayer.PlayerHistory, error) {
+
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	if _, err := db.NewPlayerDbManager(p.collection).Get(ctx, playerId); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	where := map[string]interface{}{}
+	where["playerId"] = playerId
+
+	entries, err := db.NewPlayerHistoryDbManager(p.historyCollection).Find(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	historyResp := &grpcPlayer.PlayerHistory{}
+	for _, entry := range entries {
+		historyResp.Entries = append(historyResp.Entries, entry.ToProto())
+		historyResp.Total++
+	}
+	return historyResp, nil
+}
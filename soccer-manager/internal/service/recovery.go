@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"protobuf-v1/golang"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	migrationTransferBackfill = "transferBackfill"
+	migrationHistoryBackfill  = "historyBackfill"
+)
+
+// playerMove is one move of a player between teams as far as the records tell
+type playerMove struct {
+	at           time.Time
+	sellerTeamId id.TeamID
+	buyerTeamId  id.TeamID
+	amount       int64
+	transfer     *model.Transfer
+	sellTxn      *model.Transaction
+	buyTxn       *model.Transaction
+}
+
+// BackfillTransfers writes the transactions and ledger entries missing for the recorded moves of every player
+func (t transaction) BackfillTransfers(ctx context.Context, req *grpcTxn.BackfillTransfersRequest) (*grpcTxn.BackfillTransfersResponse, error) {
+	migrations := db.NewMigrationDbManager(t.migrationCollection)
+	done, err := migrations.Get(ctx, migrationTransferBackfill)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	if done != nil && !req.Force {
+		return &grpcTxn.BackfillTransfersResponse{AlreadyDone: true, DoneAt: timestamppb.New(done.DoneAt)}, nil
+	}
+
+	players, err := db.NewPlayerDbManager(t.playerCollection).Find(ctx, map[string]interface{}{})
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	resp := &grpcTxn.BackfillTransfersResponse{}
+	for _, player := range players {
+		moves, gaps, err := t.playerMoves(ctx, player)
+		if err != nil {
+			logging.Error("failed to read player moves", logging.Fields{"playerId": player.Id.String(), "error": err.Error()})
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		resp.Players++
+		resp.Moves += int32(len(moves))
+		resp.Gaps = append(resp.Gaps, gaps...)
+
+		for _, move := range moves {
+			if move.sellerTeamId.IsZero() || move.buyerTeamId.IsZero() {
+				continue
+			}
+			txns, entries, err := t.backfillMove(ctx, player.Id, move, req.DryRun)
+			if err != nil {
+				logging.Error("failed to backfill player move", logging.Fields{"playerId": player.Id.String(), "error": err.Error()})
+				return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+			}
+			resp.Transactions += int32(txns)
+			resp.LedgerEntries += int32(entries)
+		}
+	}
+
+	logging.Info("transfer backfill finished", logging.Fields{"players": resp.Players, "moves": resp.Moves, "transactions": resp.Transactions, "ledgerEntries": resp.LedgerEntries, "gaps": len(resp.Gaps), "dryRun": req.DryRun})
+	if req.DryRun {
+		return resp, nil
+	}
+
+	marked, err := migrations.MarkDone(ctx, &model.Migration{
+		Name:    migrationTransferBackfill,
+		Summary: fmt.Sprintf("%d transactions, %d ledger entries, %d gaps", resp.Transactions, resp.LedgerEntries, len(resp.Gaps)),
+	})
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	resp.DoneAt = timestamppb.New(marked.DoneAt)
+	return resp, nil
+}
+
+// playerMoves returns the moves of the player oldest first and the gaps in them
+func (t transaction) playerMoves(ctx context.Context, player *model.Player) ([]*playerMove, []*grpcTxn.TransferGap, error) {
+	where := map[string]interface{}{}
+	where["playerId"] = player.Id
+	transfers, err := db.NewTransferDbManager(t.transferCollection).Find(ctx, where)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	where = map[string]interface{}{}
+	where["playerId"] = player.Id
+	where["type"] = map[string]interface{}{"$in": []grpcTxn.TransactionType{grpcTxn.TransactionType_TT_SELL, grpcTxn.TransactionType_TT_BUY}}
+	txns, err := db.NewTransactionDbManager(t.txnCollection).Find(ctx, where)
+	if err != nil {
+		return nil, nil, err
+	}
+	sortTransactions(txns)
+
+	var moves []*playerMove
+	transferMoves := map[id.TransferID]*playerMove{}
+	for _, transfer := range transfers {
+		move := &playerMove{at: transfer.CreatedAt, sellerTeamId: transfer.SellerTeamId, buyerTeamId: transfer.BuyerTeamId, amount: transfer.Amount, transfer: transfer}
+		transferMoves[transfer.Id] = move
+		moves = append(moves, move)
+	}
+
+	//older transactions are paired up, a purchase of the same amount right after a sale
+	var lastSale *playerMove
+	for _, txn := range txns {
+		if move, ok := transferMoves[txn.TransferId]; ok {
+			if txn.Type == grpcTxn.TransactionType_TT_SELL {
+				move.sellTxn = txn
+			} else {
+				move.buyTxn = txn
+			}
+			continue
+		}
+
+		if txn.Type == grpcTxn.TransactionType_TT_SELL {
+			lastSale = &playerMove{at: txn.CreatedAt, sellerTeamId: txn.TeamId, amount: txn.Amount, sellTxn: txn}
+			moves = append(moves, lastSale)
+			continue
+		}
+		if lastSale != nil && lastSale.buyTxn == nil && lastSale.amount == txn.Amount && lastSale.sellerTeamId != txn.TeamId {
+			lastSale.buyerTeamId = txn.TeamId
+			lastSale.buyTxn = txn
+			lastSale = nil
+			continue
+		}
+		moves = append(moves, &playerMove{at: txn.CreatedAt, buyerTeamId: txn.TeamId, amount: txn.Amount, buyTxn: txn})
+		lastSale = nil
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		return moves[i].at.Before(moves[j].at)
+	})
+
+	//the team a player joined at signup is only recorded for players created since the player history
+	where = map[string]interface{}{}
+	where["playerId"] = player.Id
+	where["type"] = grpcPlayer.PlayerHistoryType_PHT_JOINED
+	joined, err := db.NewPlayerHistoryDbManager(t.historyCollection).Find(ctx, where)
+	if err != nil {
+		return nil, nil, err
+	}
+	var origin id.TeamID
+	if len(joined) > 0 {
+		origin = joined[0].ToTeamId
+	}
+
+	current := playerOwner(player)
+
+	//a half recorded move takes its missing team from the moves around it
+	owner := origin
+	for i, move := range moves {
+		if move.sellerTeamId.IsZero() {
+			move.sellerTeamId = owner
+		}
+		if move.buyerTeamId.IsZero() {
+			if i+1 < len(moves) && !moves[i+1].sellerTeamId.IsZero() {
+				move.buyerTeamId = moves[i+1].sellerTeamId
+			} else if i+1 == len(moves) {
+				move.buyerTeamId = current
+			}
+		}
+		owner = move.buyerTeamId
+	}
+
+	var gaps []*grpcTxn.TransferGap
+	owner = origin
+	for _, move := range moves {
+		switch {
+		case move.sellerTeamId.IsZero():
+			gaps = append(gaps, transferGap(player.Id, id.TeamID{}, move.buyerTeamId, "purchase with no record of the selling team"))
+		case !owner.IsZero() && move.sellerTeamId != owner:
+			gaps = append(gaps, transferGap(player.Id, owner, move.sellerTeamId, "move with no record before a later sale"))
+		}
+		owner = move.buyerTeamId
+	}
+	if !owner.IsZero() && owner != current {
+		gaps = append(gaps, transferGap(player.Id, owner, current, "move with no record to the current team"))
+	}
+	return moves, gaps, nil
+}
+
+// BackfillHistory writes the joined entry of every player created before the player history
+func (t transaction) BackfillHistory(ctx context.Context, req *grpcTxn.BackfillHistoryRequest) (*grpcTxn.BackfillHistoryResponse, error) {
+	migrations := db.NewMigrationDbManager(t.migrationCollection)
+	done, err := migrations.Get(ctx, migrationHistoryBackfill)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	if done != nil && !req.Force {
+		return &grpcTxn.BackfillHistoryResponse{AlreadyDone: true, DoneAt: timestamppb.New(done.DoneAt)}, nil
+	}
+
+	players, err := db.NewPlayerDbManager(t.playerCollection).Find(ctx, map[string]interface{}{})
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	historyDbManager := db.NewPlayerHistoryDbManager(t.historyCollection)
+	resp := &grpcTxn.BackfillHistoryResponse{}
+	for _, player := range players {
+		resp.Players++
+
+		where := map[string]interface{}{}
+		where["playerId"] = player.Id
+		entries, err := historyDbManager.Find(ctx, where)
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		if hasJoinedEntry(entries) {
+			continue
+		}
+
+		moves, _, err := t.playerMoves(ctx, player)
+		if err != nil {
+			logging.Error("failed to read player moves", logging.Fields{"playerId": player.Id.String(), "error": err.Error()})
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		joinedTeamId, joinedValue := playerOwner(player), config.GetInt64("player.value")
+		if len(moves) > 0 {
+			joinedTeamId = moves[0].sellerTeamId
+		} else if player.Value != nil {
+			joinedValue = *player.Value
+		}
+		if len(entries) > 0 {
+			joinedValue = entries[0].OldValue
+		}
+		if joinedTeamId.IsZero() {
+			resp.UnresolvedPlayerIds = append(resp.UnresolvedPlayerIds, player.Id.String())
+			continue
+		}
+
+		resp.Entries++
+		if req.DryRun {
+			continue
+		}
+
+		historyId, err := id.NewPlayerHistoryID()
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		_, err = historyDbManager.Create(ctx, &model.PlayerHistoryEntry{
+			Id:        historyId,
+			PlayerId:  player.Id,
+			Type:      grpcPlayer.PlayerHistoryType_PHT_JOINED,
+			ToTeamId:  joinedTeamId,
+			NewValue:  joinedValue,
+			Currency:  player.Currency,
+			CreatedAt: player.CreatedAt,
+		})
+		if err != nil {
+			logging.Error("failed to backfill joined entry", logging.Fields{"playerId": player.Id.String(), "error": err.Error()})
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+	}
+
+	logging.Info("history backfill finished", logging.Fields{"players": resp.Players, "entries": resp.Entries, "unresolved": len(resp.UnresolvedPlayerIds), "dryRun": req.DryRun})
+	if req.DryRun {
+		return resp, nil
+	}
+
+	marked, err := migrations.MarkDone(ctx, &model.Migration{
+		Name:    migrationHistoryBackfill,
+		Summary: fmt.Sprintf("%d joined entries, %d unresolved players", resp.Entries, len(resp.UnresolvedPlayerIds)),
+	})
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	resp.DoneAt = timestamppb.New(marked.DoneAt)
+	return resp, nil
+}
+
+func hasJoinedEntry(entries []*model.PlayerHistoryEntry) bool {
+	for _, entry := range entries {
+		if entry.Type == grpcPlayer.PlayerHistoryType_PHT_JOINED {
+			return true
+		}
+	}
+	return false
+}
+
+// backfillMove writes the transactions and the ledger entry missing for the move
+func (t transaction) backfillMove(ctx context.Context, playerId id.PlayerID, move *playerMove, dryRun bool) (int, int, error) {
+	var missing []grpcTxn.TransactionType
+	if move.sellTxn == nil {
+		missing = append(missing, grpcTxn.TransactionType_TT_SELL)
+	}
+	if move.buyTxn == nil {
+		missing = append(missing, grpcTxn.TransactionType_TT_BUY)
+	}
+
+	var hasEntry bool
+	if move.transfer != nil {
+		where := map[string]interface{}{}
+		where["transferId"] = move.transfer.Id
+		entries, err := db.NewLedgerDbManager(t.ledgerCollection).Find(ctx, where)
+		if err != nil {
+			return 0, 0, err
+		}
+		hasEntry = len(entries) > 0
+	}
+	missingEntry := move.transfer != nil && !hasEntry
+
+	if len(missing) == 0 && !missingEntry {
+		return 0, 0, nil
+	}
+	entryCount := 0
+	if missingEntry {
+		entryCount = 1
+	}
+	if dryRun {
+		return len(missing), entryCount, nil
+	}
+
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+		for _, txnType := range missing {
+			teamId := move.buyerTeamId
+			if txnType == grpcTxn.TransactionType_TT_SELL {
+				teamId = move.sellerTeamId
+			}
+			team, err := db.NewTeamDbManager(t.teamCollection).Get(sessionContext, teamId)
+			if err != nil {
+				return nil, err
+			}
+			//the budget at the time is not known
+			team.Budget = nil
+
+			logging.Info("backfilling transfer transaction", logging.Fields{"playerId": playerId.String(), "teamId": teamId.String(), "at": move.at.Format(time.RFC3339)})
+			_, err = t.createTransaction(sessionContext, &createTransactionRequest{
+				teamModel:   team,
+				amount:      move.amount,
+				txnType:     txnType,
+				playerModel: &model.Player{Id: playerId},
+				transfer:    move.transfer,
+				description: "Recovered from transfer",
+				createdAt:   move.at,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if missingEntry {
+			logging.Info("backfilling transfer ledger entry", logging.Fields{"transferId": move.transfer.Id.String()})
+			_, err := postLedgerEntryAt(sessionContext, t.ledgerCollection, move.transfer.Id, "Recovered from transfer", move.at,
+				teamBudgetPosting(move.buyerTeamId, -move.amount),
+				teamBudgetPosting(move.sellerTeamId, move.amount),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+
+	if _, err := runInTransaction(ctx, t.mongoClient, callback); err != nil {
+		return 0, 0, err
+	}
+	return len(missing), entryCount, nil
+}
+
+func sortTransactions(txns []*model.Transaction) {
+	sort.SliceStable(txns, func(i, j int) bool {
+		return txns[i].CreatedAt.Before(txns[j].CreatedAt)
+	})
+}
+
+// playerOwner is the team the player belongs to, a player on loan still belongs to its parent team
+func playerOwner(player *model.Player) id.TeamID {
+	if player.IsOnLoan() && player.ParentTeamId != nil {
+		return *player.ParentTeamId
+	}
+	return player.TeamId
+}
+
+func transferGap(playerId id.PlayerID, fromTeamId id.TeamID, toTeamId id.TeamID, reason string) *grpcTxn.TransferGap {
+	return &grpcTxn.TransferGap{
+		PlayerId:   playerId.String(),
+		FromTeamId: fromTeamId.String(),
+		ToTeamId:   toTeamId.String(),
+		Reason:     reason,
+	}
+}
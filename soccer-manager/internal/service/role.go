@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"protobuf-v1/golang"
+	grpcLogin "protobuf-v1/golang/login"
+	grpcUser "protobuf-v1/golang/user"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// SetRole changes the role of a user and revokes the user's sessions
+func (l login) SetRole(ctx context.Context, req *grpcLogin.SetRoleRequest) (*grpcUser.User, error) {
+	userId, err := id.ParseUserID(req.UserId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, err.Error())
+	}
+
+	if _, ok := grpcUser.Role_name[int32(req.Role)]; !ok || req.Role == grpcUser.Role_ROLE_UNSPECIFIED {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "unknown role")
+	}
+
+	user, err := l.updateUser(ctx, &model.User{Id: userId, Role: req.Role})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := l.revokeUserSessions(ctx, userId, id.SessionID{}); err != nil {
+		return nil, err
+	}
+	return user.ToProto(), nil
+}
+
+// SetFrozen freezes or unfreezes an account, freezing revokes its sessions and api keys
+func (l login) SetFrozen(ctx context.Context, req *grpcLogin.SetFrozenRequest) (*grpcUser.User, error) {
+	userId, err := id.ParseUserID(req.UserId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, err.Error())
+	}
+
+	update := &model.User{Id: userId, IsFrozen: &req.Frozen}
+	if req.Frozen {
+		now := time.Now()
+		update.FrozenAt = &now
+	}
+	user, err := l.updateUser(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Frozen {
+		if err := l.revokeUserSessions(ctx, userId, id.SessionID{}); err != nil {
+			return nil, err
+		}
+		if err := l.revokeUserApiKeys(ctx, userId); err != nil {
+			return nil, err
+		}
+	}
+	return user.ToProto(), nil
+}
+
+func (l login) updateUser(ctx context.Context, update *model.User) (*model.User, error) {
+	user, err := db.NewUserDbManager(l.userCollection).Update(ctx, update)
+	if err == mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND, "user not found")
+	}
+	if err != nil {
+		logging.Error("failed to update user", logging.Fields{"userId": update.Id.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to update user")
+	}
+	return user, nil
+}
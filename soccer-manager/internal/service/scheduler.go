@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"soccer-manager/util/logging"
+	"time"
+)
+
+type scheduler struct {
+	name           string
+	interval       time.Duration
+	job            func(context.Context) error
+	asyncWaitGroup AsyncWaitGroup
+	stop           chan struct{}
+}
+
+type Scheduler interface {
+	Start()
+	Stop()
+}
+
+// NewScheduler runs job every interval in the background until Stop is called
+func NewScheduler(name string, interval time.Duration, job func(context.Context) error, asyncWaitGroup AsyncWaitGroup) Scheduler {
+	return &scheduler{
+		name:           name,
+		interval:       interval,
+		job:            job,
+		asyncWaitGroup: asyncWaitGroup,
+		stop:           make(chan struct{}),
+	}
+}
+
+func (s *scheduler) Start() {
+	s.asyncWaitGroup.Add(1)
+	go func() {
+		defer s.asyncWaitGroup.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				if err := s.job(context.Background()); err != nil {
+					logging.Error("scheduled job failed", logging.Fields{"job": s.name, "error": err.Error()})
+				}
+			}
+		}
+	}()
+}
+
+func (s *scheduler) Stop() {
+	close(s.stop)
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"protobuf-v1/golang"
+	grpcLogin "protobuf-v1/golang/login"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/jwt"
+	"soccer-manager/util/logging"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// accessTokenLifetime is how long an access token is accepted
+func accessTokenLifetime() time.Duration {
+	return time.Duration(config.GetInt64("jwt.expirationSeconds")) * time.Second
+}
+
+// refreshTokenLifetime is how long a session can go without refreshing before its user has to login again
+func refreshTokenLifetime() time.Duration {
+	return time.Duration(config.GetInt64("jwt.refreshExpirationSeconds")) * time.Second
+}
+
+// RefreshToken trades a refresh token for new tokens, a reused refresh token revokes its session
+func (l login) RefreshToken(ctx context.Context, req *grpcLogin.RefreshTokenRequest) (*grpcLogin.LoginResponse, error) {
+	tokenId, secret, err := parseRefreshToken(req.RefreshToken)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+	}
+
+	token, err := db.NewRefreshTokenDbManager(l.refreshTokenCollection).Get(ctx, tokenId)
+	if err == mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "unknown refresh token")
+	}
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	if !secretMatches(secret, token.SecretHash) {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "unknown refresh token")
+	}
+
+	if token.UsedAt != nil || token.RevokedAt != nil {
+		logging.Warn("used refresh token presented, revoking session", logging.Fields{"userId": token.UserId.String(), "sessionId": token.SessionId.String()})
+		if err := l.revokeSession(ctx, token.UserId, token.SessionId); err != nil {
+			return nil, err
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_REVOKED, "refresh token already used, session revoked")
+	}
+
+	now := time.Now()
+	if !token.ExpiresAt.After(now) {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "refresh token expired")
+	}
+
+	//the user is read again so a frozen account or new role takes effect
+	user, err := db.NewUserDbManager(l.userCollection).Get(ctx, token.UserId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "user of the refresh token not found")
+	}
+	if user.Frozen() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_ACCOUNT_FROZEN)
+	}
+
+	//rotate - the presented token is marked used and its successor created together
+	result, err := runInTransaction(ctx, l.mongoClient, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		tokenFilters := map[string]interface{}{}
+		tokenFilters["usedAt"] = nil
+		tokenFilters["revokedAt"] = nil
+		_, err := db.NewRefreshTokenDbManager(l.refreshTokenCollection).Update(sessionContext, &model.RefreshToken{Id: token.Id, UsedAt: &now}, tokenFilters)
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_REVOKED, "refresh token already used")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return l.createRefreshToken(sessionContext, token.UserId, token.TeamId, token.SessionId)
+	})
+	if err != nil {
+		if grpcError.IsGRPCError(err) {
+			return nil, err
+		}
+		logging.Error("failed to rotate refresh token", logging.Fields{"sessionId": token.SessionId.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to refresh token")
+	}
+
+	return l.tokenResponse(ctx, user, result.(*issuedRefreshToken))
+}
+
+// Logout revokes the session of the access token, or every session of its user
+func (l login) Logout(ctx context.Context, req *grpcLogin.LogoutRequest) (*grpcLogin.LogoutResponse, error) {
+	claims, err := l.validClaims(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := id.ParseUserID(claims.UserId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+	}
+	sessionId, err := id.ParseSessionID(claims.SessionId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+	}
+
+	if err := l.revokeSession(ctx, userId, sessionId); err != nil {
+		return nil, err
+	}
+	if req.AllSessions {
+		if err := l.revokeUserSessions(ctx, userId, sessionId); err != nil {
+			return nil, err
+		}
+	}
+	return &grpcLogin.LogoutResponse{}, nil
+}
+
+func (l login) ValidateJWT(ctx context.Context, req *grpcLogin.ValidateJWTRequest) (*grpcLogin.ValidateJWTResponse, error) {
+	claims, err := l.validClaims(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+	//tokens issued before roles were introduced belong to managers
+	role := claims.Role
+	if role == "" {
+		role = string(util.RoleManager)
+	}
+	return &grpcLogin.ValidateJWTResponse{
+		UserId: claims.UserId,
+		TeamId: claims.TeamId,
+		Role:   role,
+	}, nil
+}
+
+// GetJWKS returns the public keys tokens are verified with
+func (l login) GetJWKS(ctx context.Context, req *grpcLogin.GetJWKSRequest) (*grpcLogin.GetJWKSResponse, error) {
+	resp := &grpcLogin.GetJWKSResponse{}
+	for _, key := range l.keys.JWKS().Keys {
+		resp.Keys = append(resp.Keys, &grpcLogin.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Alg: key.Alg,
+			Use: key.Use,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return resp, nil
+}
+
+// validClaims parses the access token and checks its session against the revocation list
+func (l login) validClaims(ctx context.Context, token string) (*jwt.Claims, error) {
+	if token == "" {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "token missing")
+	}
+
+	claims := &jwt.Claims{}
+	err := jwt.ParseToken(ctx, token, claims, l.keys)
+	if err != nil {
+		return nil, err
+	}
+
+	// tokens issued before sessions were introduced can not be revoked, their users have to login again
+	sessionId, err := id.ParseSessionID(claims.SessionId)
+	if err != nil || sessionId.IsZero() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "token has no session, login again")
+	}
+
+	revoked, err := db.NewRevokedSessionDbManager(l.revokedSessionCollection).IsRevoked(ctx, sessionId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	if revoked {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_REVOKED, "session revoked")
+	}
+	return claims, nil
+}
+
+// revokeSession puts the session on the revocation list and revokes its refresh tokens
+func (l login) revokeSession(ctx context.Context, userId id.UserID, sessionId id.SessionID) error {
+	err := db.NewRevokedSessionDbManager(l.revokedSessionCollection).Revoke(ctx, &model.RevokedSession{
+		Id:        sessionId,
+		UserId:    userId,
+		ExpiresAt: time.Now().Add(accessTokenLifetime()),
+	})
+	if err != nil {
+		logging.Error("failed to revoke session", logging.Fields{"sessionId": sessionId.String(), "error": err.Error()})
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to revoke session")
+	}
+
+	if _, err := db.NewRefreshTokenDbManager(l.refreshTokenCollection).RevokeSession(ctx, sessionId, time.Now()); err != nil {
+		logging.Error("failed to revoke refresh tokens", logging.Fields{"sessionId": sessionId.String(), "error": err.Error()})
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to revoke session")
+	}
+	return nil
+}
+
+// revokeUserSessions revokes every session of the user that still has refresh tokens, except the one to keep
+func (l login) revokeUserSessions(ctx context.Context, userId id.UserID, keep id.SessionID) error {
+	where := map[string]interface{}{}
+	where["userId"] = userId
+	where["revokedAt"] = nil
+	tokens, err := db.NewRefreshTokenDbManager(l.refreshTokenCollection).Find(ctx, where)
+	if err != nil {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	revoked := map[id.SessionID]bool{keep: true}
+	for _, token := range tokens {
+		if revoked[token.SessionId] {
+			continue
+		}
+		if err := l.revokeSession(ctx, userId, token.SessionId); err != nil {
+			return err
+		}
+		revoked[token.SessionId] = true
+	}
+	return nil
+}
+
+// newSession starts a session for the user and issues its first tokens
+func (l login) newSession(ctx context.Context, user *model.User) (*grpcLogin.LoginResponse, error) {
+	sessionId, err := id.NewSessionID()
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	issued, err := l.createRefreshToken(ctx, user.Id, user.TeamId, sessionId)
+	if err != nil {
+		logging.Error("failed to create refresh token", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to create session")
+	}
+
+	return l.tokenResponse(ctx, user, issued)
+}
+
+// issuedRefreshToken is a stored refresh token with the token handed to the client
+type issuedRefreshToken struct {
+	token string
+	model *model.RefreshToken
+}
+
+func (l login) createRefreshToken(ctx context.Context, userId id.UserID, teamId id.TeamID, sessionId id.SessionID) (*issuedRefreshToken, error) {
+	tokenId, err := id.NewRefreshTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	secret, secretHash, err := newTokenSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenModel, err := db.NewRefreshTokenDbManager(l.refreshTokenCollection).Create(ctx, &model.RefreshToken{
+		Id:         tokenId,
+		SessionId:  sessionId,
+		UserId:     userId,
+		TeamId:     teamId,
+		SecretHash: secretHash,
+		ExpiresAt:  time.Now().Add(refreshTokenLifetime()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &issuedRefreshToken{token: tokenId.String() + "." + secret, model: tokenModel}, nil
+}
+
+// tokenResponse issues an access token carrying the user's role for the session of the refresh token
+func (l login) tokenResponse(ctx context.Context, user *model.User, refresh *issuedRefreshToken) (*grpcLogin.LoginResponse, error) {
+	tokenExpirationSeconds := config.GetInt32("jwt.expirationSeconds")
+	role := string(util.RoleFromProto[user.GetRole()])
+	token, err := jwt.GenerateToken(ctx, l.keys, refresh.model.UserId, refresh.model.TeamId, refresh.model.SessionId, role, tokenExpirationSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcLogin.LoginResponse{
+		AccessToken:          token,
+		TokenType:            "Bearer",
+		UserId:               refresh.model.UserId.String(),
+		TokenValidity:        timestamppb.New(time.Now().Add(time.Second * time.Duration(tokenExpirationSeconds))),
+		RefreshToken:         refresh.token,
+		RefreshTokenValidity: timestamppb.New(refresh.model.ExpiresAt),
+	}, nil
+}
+
+// parseRefreshToken splits a refresh token into the id of its stored record and its secret
+func parseRefreshToken(token string) (id.RefreshTokenID, string, error) {
+	rawId, secret, err := splitSecretToken(token)
+	if err != nil {
+		return id.RefreshTokenID{}, "", errors.New("malformed refresh token")
+	}
+	tokenId, err := id.ParseRefreshTokenID(rawId)
+	if err != nil || tokenId.IsZero() {
+		return id.RefreshTokenID{}, "", errors.New("malformed refresh token")
+	}
+	return tokenId, secret, nil
+}
+
+// newTokenSecret draws the secret of a token handed to a user and the hash it is stored as
+func newTokenSecret() (string, string, error) {
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+	return secret, hashTokenSecret(secret), nil
+}
+
+func splitSecretToken(token string) (string, string, error) {
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("malformed token")
+	}
+	return parts[0], parts[1], nil
+}
+
+func hashTokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func secretMatches(secret string, secretHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashTokenSecret(secret)), []byte(secretHash)) == 1
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"math/rand"
+	grpcPlayer "protobuf-v1/golang/player"
+	"soccer-manager/internal/model"
+)
+
+// skillEmphasis is how much a skill matters for a position
+type skillEmphasis struct {
+	min    int32
+	max    int32
+	weight int32
+}
+
+var (
+	skillKey    = skillEmphasis{min: 60, max: 90, weight: 3}
+	skillUseful = skillEmphasis{min: 45, max: 75, weight: 2}
+	skillMinor  = skillEmphasis{min: 25, max: 55, weight: 1}
+	skillNone   = skillEmphasis{min: 1, max: 20, weight: 0}
+)
+
+// positionSkills lists the emphasis of pace, shooting, passing, defending, goalkeeping and stamina for each position
+var positionSkills = map[grpcPlayer.PlayerType][6]skillEmphasis{
+	grpcPlayer.PlayerType_PT_GOAL_KEEPER: {skillMinor, skillNone, skillUseful, skillMinor, skillKey, skillMinor},
+	grpcPlayer.PlayerType_PT_DEFENDER:    {skillUseful, skillMinor, skillUseful, skillKey, skillNone, skillUseful},
+	grpcPlayer.PlayerType_PT_MID_FIELDER: {skillUseful, skillUseful, skillKey, skillMinor, skillNone, skillKey},
+	grpcPlayer.PlayerType_PT_ATTACKER:    {skillKey, skillKey, skillUseful, skillNone, skillNone, skillUseful},
+}
+
+// newPlayerSkills draws the skills of a new player, each one from the range of its emphasis for the player's position
+func newPlayerSkills(playerType grpcPlayer.PlayerType) *model.PlayerSkills {
+	emphasis, ok := positionSkills[playerType]
+	if !ok {
+		emphasis = [6]skillEmphasis{skillMinor, skillMinor, skillMinor, skillMinor, skillNone, skillMinor}
+	}
+
+	var ratings [6]int32
+	var weighted, weights int32
+	for i, e := range emphasis {
+		ratings[i] = rand.Int31n(e.max-e.min+1) + e.min
+		weighted += ratings[i] * e.weight
+		weights += e.weight
+	}
+
+	return &model.PlayerSkills{
+		Pace:        ratings[0],
+		Shooting:    ratings[1],
+		Passing:     ratings[2],
+		Defending:   ratings[3],
+		Goalkeeping: ratings[4],
+		Stamina:     ratings[5],
+		Overall:     (weighted + weights/2) / weights,
+	}
+}
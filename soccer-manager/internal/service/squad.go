@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"protobuf-v1/golang"
+	grpcLogin "protobuf-v1/golang/login"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// squadPositions is the order the players of a new squad are created in
+var squadPositions = []grpcPlayer.PlayerType{
+	grpcPlayer.PlayerType_PT_GOAL_KEEPER,
+	grpcPlayer.PlayerType_PT_DEFENDER,
+	grpcPlayer.PlayerType_PT_MID_FIELDER,
+	grpcPlayer.PlayerType_PT_ATTACKER,
+}
+
+// squadSize is the number of players a new team gets for each position
+func squadSize() map[grpcPlayer.PlayerType]int {
+	return map[grpcPlayer.PlayerType]int{
+		grpcPlayer.PlayerType_PT_GOAL_KEEPER: config.GetInt("team.goalKeepers"),
+		grpcPlayer.PlayerType_PT_DEFENDER:    config.GetInt("team.defenders"),
+		grpcPlayer.PlayerType_PT_MID_FIELDER: config.GetInt("team.midFielders"),
+		grpcPlayer.PlayerType_PT_ATTACKER:    config.GetInt("team.attackers"),
+	}
+}
+
+// RepairTeams checks that one or every user has its team and full squad, with repair set the missing ones are created
+func (l login) RepairTeams(ctx context.Context, req *grpcLogin.RepairTeamsRequest) (*grpcLogin.RepairTeamsResponse, error) {
+
+	where := map[string]interface{}{}
+	if req.UserId != "" {
+		userId, err := id.ParseUserID(req.UserId)
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+		}
+		where["_id"] = userId
+	}
+
+	users, err := db.NewUserDbManager(l.userCollection).Find(ctx, where)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	if req.UserId != "" && len(users) == 0 {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+	}
+
+	repairResp := &grpcLogin.RepairTeamsResponse{}
+	for _, user := range users {
+		check, err := l.checkSquad(ctx, user, req.Repair)
+		if err != nil {
+			logging.Error("failed to check squad", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		if check.Ambiguous {
+			repairResp.Ambiguous++
+		}
+		if check.MissingTeam || check.MissingPlayers > 0 {
+			repairResp.Incomplete++
+		}
+		repairResp.Users = append(repairResp.Users, check)
+		repairResp.Total++
+	}
+	return repairResp, nil
+}
+
+func (l login) checkSquad(ctx context.Context, user *model.User, repair bool) (*grpcLogin.SquadCheck, error) {
+	result, err := runInTransaction(ctx, l.mongoClient, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		check := &grpcLogin.SquadCheck{UserId: user.Id.String(), TeamId: user.TeamId.String()}
+
+		missing := squadSize()
+		_, err := db.NewTeamDbManager(l.teamCollection).Get(sessionContext, user.TeamId)
+		switch {
+		case err == mongo.ErrNoDocuments:
+			check.MissingTeam = true
+		case err != nil:
+			return nil, err
+		default:
+			var reason string
+			missing, reason, err = l.getMissingPlayers(sessionContext, user.TeamId)
+			if err != nil {
+				return nil, err
+			}
+			if reason != "" {
+				check.Ambiguous = true
+				check.Reason = reason
+				return check, nil
+			}
+		}
+		for _, count := range missing {
+			check.MissingPlayers += int32(count)
+		}
+
+		if !repair || (!check.MissingTeam && check.MissingPlayers == 0) {
+			return check, nil
+		}
+
+		//repair - missing team with its squad, or the missing players
+		if check.MissingTeam {
+			err = l.createTeam(sessionContext, user.Id, user.TeamId)
+		} else {
+			err = l.createTeamPlayers(sessionContext, user.TeamId, missing)
+		}
+		if err != nil {
+			return nil, err
+		}
+		check.Repaired = true
+		return check, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*grpcLogin.SquadCheck), nil
+}
+
+// getMissingPlayers counts the missing players of the team per position, or returns why the squad is ambiguous
+func (l login) getMissingPlayers(sessionContext mongo.SessionContext, teamId id.TeamID) (map[grpcPlayer.PlayerType]int, string, error) {
+	created := map[grpcPlayer.PlayerType]int{}
+
+	where := map[string]interface{}{}
+	where["toTeamId"] = teamId
+	where["type"] = grpcPlayer.PlayerHistoryType_PHT_JOINED
+	joined, err := db.NewPlayerHistoryDbManager(l.historyCollection).Find(sessionContext, where)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(joined) > 0 {
+		var playerIds []id.PlayerID
+		for _, entry := range joined {
+			playerIds = append(playerIds, entry.PlayerId)
+		}
+		where = map[string]interface{}{}
+		where["_id"] = map[string]interface{}{"$in": playerIds}
+		players, err := db.NewPlayerDbManager(l.playerCollection).Find(sessionContext, where)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, player := range players {
+			created[player.Type]++
+		}
+	} else {
+		traded, err := l.hasTraded(sessionContext, teamId)
+		if err != nil {
+			return nil, "", err
+		}
+		if traded {
+			return nil, "team has traded players and none has a joined entry", nil
+		}
+
+		where = map[string]interface{}{}
+		where["$or"] = []map[string]interface{}{{"teamId": teamId}, {"parentTeamId": teamId}}
+		owned, err := db.NewPlayerDbManager(l.playerCollection).Find(sessionContext, where)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, player := range owned {
+			created[player.Type]++
+		}
+	}
+
+	missing := map[grpcPlayer.PlayerType]int{}
+	for playerType, size := range squadSize() {
+		if created[playerType] > size {
+			return nil, "team was created with more players than a squad has now", nil
+		}
+		if created[playerType] < size {
+			missing[playerType] = size - created[playerType]
+		}
+	}
+	return missing, "", nil
+}
+
+// hasTraded reports whether the team ever bought, sold or lent a player
+func (l login) hasTraded(sessionContext mongo.SessionContext, teamId id.TeamID) (bool, error) {
+	where := map[string]interface{}{}
+	where["$or"] = []map[string]interface{}{{"buyerTeamId": teamId}, {"sellerTeamId": teamId}}
+	transfers, err := db.NewTransferDbManager(l.transferCollection).Find(sessionContext, where)
+	if err != nil {
+		return false, err
+	}
+	if len(transfers) > 0 {
+		return true, nil
+	}
+
+	where = map[string]interface{}{}
+	where["teamId"] = teamId
+	where["type"] = map[string]interface{}{"$in": []grpcTxn.TransactionType{
+		grpcTxn.TransactionType_TT_BUY,
+		grpcTxn.TransactionType_TT_SELL,
+		grpcTxn.TransactionType_TT_LOAN_IN,
+		grpcTxn.TransactionType_TT_LOAN_OUT,
+	}}
+	txns, err := db.NewTransactionDbManager(l.txnCollection).Find(sessionContext, where)
+	if err != nil {
+		return false, err
+	}
+	return len(txns) > 0, nil
+}
@@ -6,21 +6,27 @@ import (
 
 	"protobuf-v1/golang"
 	grpcTeam "protobuf-v1/golang/team"
+	grpcTxn "protobuf-v1/golang/transaction"
 	"soccer-manager/internal/db"
 	grpcError "soccer-manager/util/error"
 	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
 
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type team struct {
-	collection *mongo.Collection
+	collection       *mongo.Collection
+	ledgerCollection *mongo.Collection
+	mongoClient      *mongo.Client
 	grpcTeam.UnimplementedTeamServiceServer
 }
 
-func NewTeamService(collection *mongo.Collection) grpcTeam.TeamServiceServer {
+func NewTeamService(collections Collections, mongoClient *mongo.Client) grpcTeam.TeamServiceServer {
 	return team{
-		collection: collection,
+		collection:       collections.Team,
+		ledgerCollection: collections.Ledger,
+		mongoClient:      mongoClient,
 	}
 }
 
@@ -65,6 +71,7 @@ func (t team) Update(ctx context.Context, req *grpcTeam.UpdateRequest) (*grpcTea
 
 	if req.Budget != nil {
 		updateModel.Budget = &req.Budget.Value
+		return t.updateBudget(ctx, updateModel)
 	}
 
 	teamResp, err := db.NewTeamDbManager(t.collection).Update(ctx, updateModel)
@@ -77,3 +84,61 @@ func (t team) Update(ctx context.Context, req *grpcTeam.UpdateRequest) (*grpcTea
 
 	return teamResp.ToProto(), nil
 }
+
+// updateBudget sets the budget directly, the difference is posted to the ledger as an adjustment
+func (t team) updateBudget(ctx context.Context, updateModel *model.Team) (*grpcTeam.Team, error) {
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+		oldTeam, err := db.NewTeamDbManager(t.collection).Get(sessionContext, updateModel.Id)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_NOT_FOUND)
+			}
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if oldTeam.Reserved != nil && *updateModel.Budget < *oldTeam.Reserved {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "budget can not be lower than the reserved amount")
+		}
+
+		var oldBudget int64
+		if oldTeam.Budget != nil {
+			oldBudget = *oldTeam.Budget
+		}
+		teamFilters := map[string]interface{}{}
+		teamFilters["budget"] = oldTeam.Budget
+		teamFilters["reserved"] = oldTeam.Reserved
+
+		teamResp, err := db.NewTeamDbManager(t.collection).Update(sessionContext, updateModel, teamFilters)
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if adjustment := *updateModel.Budget - oldBudget; adjustment != 0 {
+			_, err = postLedgerEntry(sessionContext, t.ledgerCollection, id.TransferID{}, "Budget adjustment",
+				accountPosting(grpcTxn.LedgerAccount_LA_ADJUSTMENTS, -adjustment),
+				teamBudgetPosting(updateModel.Id, adjustment),
+			)
+			if err != nil {
+				return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+			}
+		}
+		return teamResp, nil
+	}
+
+	result, err := runInTransaction(ctx, t.mongoClient, callback)
+	if err != nil {
+		logging.Error("budget update failed", logging.Fields{"error": err.Error()})
+		if grpcError.IsGRPCError(err) {
+			return nil, err
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "budget update failed due to internal error")
+	}
+
+	teamResp, ok := result.(*model.Team)
+	if !ok || teamResp == nil {
+		logging.Error("transaction returned invalid response")
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "budget update failed due to internal error")
+	}
+
+	return teamResp.ToProto(), nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"protobuf-v1/golang"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// loginAttemptKey is a failed login counter of an email or a client ip
+type loginAttemptKey struct {
+	key          string
+	freeAttempts int32
+}
+
+func loginAttemptKeys(email string, ip string) []loginAttemptKey {
+	keys := []loginAttemptKey{{
+		key:          "email:" + strings.ToLower(strings.TrimSpace(email)),
+		freeAttempts: config.GetInt32("loginThrottle.emailFreeAttempts"),
+	}}
+	if ip != "" {
+		keys = append(keys, loginAttemptKey{
+			key:          "ip:" + ip,
+			freeAttempts: config.GetInt32("loginThrottle.ipFreeAttempts"),
+		})
+	}
+	return keys
+}
+
+// attemptLockedUntil returns until when the counter blocks logins
+func attemptLockedUntil(attempt *model.LoginAttempt, freeAttempts int32) time.Time {
+	if attempt == nil || attempt.Failures <= freeAttempts {
+		return time.Time{}
+	}
+
+	maxDelay := time.Duration(config.GetInt64("loginThrottle.maxLockoutSeconds")) * time.Second
+	delay := time.Duration(config.GetInt64("loginThrottle.baseDelaySeconds")) * time.Second
+	for i := freeAttempts + 1; i < attempt.Failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return attempt.LastFailureAt.Add(delay)
+}
+
+// loginAttemptReservation is a login attempt counted as failed before the password or code is checked
+type loginAttemptReservation struct {
+	keys []loginAttemptKey
+	at   time.Time
+	// previous are the counters of the keys before the attempt, nil for a key without one
+	previous []*model.LoginAttempt
+}
+
+// reserveLoginAttempt counts the attempt on every key and refuses it while the email or ip is locked
+func (l login) reserveLoginAttempt(ctx context.Context, keys []loginAttemptKey, email string, ip string) (*loginAttemptReservation, error) {
+	//mongo keeps milliseconds, the time has to match the stored one when the attempt is taken back
+	now := time.Now().Truncate(time.Millisecond)
+	expiresAt := now.Add(time.Duration(config.GetInt64("loginThrottle.resetSeconds")) * time.Second)
+
+	reservation := &loginAttemptReservation{at: now}
+	var lockedUntil time.Time
+	for _, key := range keys {
+		previous, err := l.loginAttempts.Reserve(ctx, key.key, now, expiresAt)
+		if err != nil {
+			logging.Error("failed to count login attempt", logging.Fields{"key": key.key, "error": err.Error()})
+			l.releaseLoginAttempt(ctx, reservation)
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to check login attempts")
+		}
+		reservation.keys = append(reservation.keys, key)
+		reservation.previous = append(reservation.previous, previous)
+		if until := attemptLockedUntil(previous, key.freeAttempts); until.After(lockedUntil) {
+			lockedUntil = until
+		}
+	}
+
+	if !lockedUntil.After(now) {
+		return reservation, nil
+	}
+
+	l.releaseLoginAttempt(ctx, reservation)
+	l.auditLogin(ctx, &model.LoginAudit{Email: email, Ip: ip, Reason: model.LoginAuditReasonLocked, LockedUntil: &lockedUntil})
+	retryAfter := int64(lockedUntil.Sub(now)/time.Second) + 1
+	return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOO_MANY_ATTEMPTS, fmt.Sprintf("too many failed logins, retry in %d seconds", retryAfter))
+}
+
+// releaseLoginAttempt takes back an attempt that did not fail
+func (l login) releaseLoginAttempt(ctx context.Context, reservation *loginAttemptReservation) {
+	for i, key := range reservation.keys {
+		if err := l.loginAttempts.Release(ctx, key.key, reservation.at, reservation.previous[i]); err != nil {
+			logging.Error("failed to release login attempt", logging.Fields{"key": key.key, "error": err.Error()})
+		}
+	}
+}
+
+// loginFailed audits the failure, it was counted when the attempt was reserved
+func (l login) loginFailed(ctx context.Context, reservation *loginAttemptReservation, email string, ip string, userId id.UserID, reason model.LoginAuditReason) {
+	audit := &model.LoginAudit{Email: email, Ip: ip, UserId: userId, Reason: reason}
+	var lockedUntil time.Time
+	for i, key := range reservation.keys {
+		attempt := &model.LoginAttempt{Key: key.key, Failures: 1, LastFailureAt: reservation.at}
+		if previous := reservation.previous[i]; previous != nil {
+			attempt.Failures += previous.Failures
+		}
+		if i == 0 {
+			audit.Failures = attempt.Failures
+		}
+		if until := attemptLockedUntil(attempt, key.freeAttempts); until.After(lockedUntil) {
+			lockedUntil = until
+		}
+	}
+	if lockedUntil.After(reservation.at) {
+		audit.LockedUntil = &lockedUntil
+	}
+	l.auditLogin(ctx, audit)
+}
+
+// loginSucceeded clears the counter of the email, the ip's is kept
+func (l login) loginSucceeded(ctx context.Context, keys []loginAttemptKey) {
+	if err := l.loginAttempts.Reset(ctx, keys[0].key); err != nil {
+		logging.Error("failed to reset login attempts", logging.Fields{"key": keys[0].key, "error": err.Error()})
+	}
+}
+
+func (l login) auditLogin(ctx context.Context, audit *model.LoginAudit) {
+	auditId, err := id.NewLoginAuditID()
+	if err != nil {
+		logging.Error("failed to create login audit id", logging.Fields{"error": err.Error()})
+		return
+	}
+	audit.Id = auditId
+	audit.ExpiresAt = time.Now().Add(time.Duration(config.GetInt64("loginThrottle.auditRetentionSeconds")) * time.Second)
+
+	fields := logging.Fields{"email": audit.Email, "ip": audit.Ip, "reason": string(audit.Reason), "failures": audit.Failures}
+	if audit.LockedUntil != nil {
+		fields["lockedUntil"] = audit.LockedUntil.Format(time.RFC3339)
+	}
+	logging.Warn("login failed", fields)
+
+	if err := l.loginAttempts.Audit(ctx, audit); err != nil {
+		logging.Error("failed to audit login", logging.Fields{"email": audit.Email, "error": err.Error()})
+	}
+}
+
+// NewLoginAttemptStore returns the store loginThrottle.store names, mongo unless it is memory
+func NewLoginAttemptStore(attemptCollection *mongo.Collection, auditCollection *mongo.Collection) (db.LoginAttemptStore, error) {
+	switch config.GetString("loginThrottle.store") {
+	case "", "mongo":
+		return db.NewLoginAttemptDbManager(attemptCollection, auditCollection), nil
+	case "memory":
+		return db.NewLoginAttemptMemoryStore(config.GetInt("loginThrottle.memoryAuditSize")), nil
+	default:
+		return nil, fmt.Errorf("unknown login attempt store %q", config.GetString("loginThrottle.store"))
+	}
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"protobuf-v1/golang"
+	grpcLogin "protobuf-v1/golang/login"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+	"soccer-manager/util/totp"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EnrollTOTP starts two factor authentication with a new secret that takes effect once confirmed
+func (l login) EnrollTOTP(ctx context.Context, req *grpcLogin.EnrollTOTPRequest) (*grpcLogin.EnrollTOTPResponse, error) {
+	user, _, err := l.tokenUser(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TotpEnabled() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_ALREADY_EXISTS, "two factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	if _, err := l.updateUser(ctx, &model.User{Id: user.Id, TotpPendingSecret: secret}); err != nil {
+		return nil, err
+	}
+
+	return &grpcLogin.EnrollTOTPResponse{
+		Secret:          secret,
+		ProvisioningUri: totp.ProvisioningURI(config.GetString("totp.issuer"), user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP enables two factor authentication with a code of the enrolled secret
+func (l login) ConfirmTOTP(ctx context.Context, req *grpcLogin.ConfirmTOTPRequest) (*grpcLogin.RecoveryCodesResponse, error) {
+	user, sessionId, err := l.tokenUser(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TotpEnabled() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_ALREADY_EXISTS, "two factor authentication is already enabled")
+	}
+	if user.TotpPendingSecret == "" {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "no two factor enrollment to confirm, enroll first")
+	}
+
+	step, ok := totp.Validate(user.TotpPendingSecret, req.Code, time.Now(), config.GetInt64("totp.skewSteps"))
+	if !ok {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "invalid code")
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	_, err = db.NewUserDbManager(l.userCollection).EnableTOTP(ctx, user.Id, user.TotpPendingSecret, step, hashes, time.Now())
+	if err == mongo.ErrNoDocuments {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "two factor enrollment changed, enroll again")
+	}
+	if err != nil {
+		logging.Error("failed to enable totp", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to enable two factor authentication")
+	}
+
+	if err := l.revokeUserSessions(ctx, user.Id, sessionId); err != nil {
+		return nil, err
+	}
+	return &grpcLogin.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP turns two factor authentication off with the password and a code or recovery code
+func (l login) DisableTOTP(ctx context.Context, req *grpcLogin.DisableTOTPRequest) (*grpcLogin.DisableTOTPResponse, error) {
+	user, sessionId, err := l.tokenUser(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.TotpEnabled() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "two factor authentication is not enabled")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "password not matching")
+	}
+
+	if err := l.checkSecondFactor(ctx, user, "", req.Code, req.RecoveryCode); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.NewUserDbManager(l.userCollection).DisableTOTP(ctx, user.Id); err != nil {
+		logging.Error("failed to disable totp", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to disable two factor authentication")
+	}
+
+	if err := l.revokeUserSessions(ctx, user.Id, sessionId); err != nil {
+		return nil, err
+	}
+	if err := l.revokeUserApiKeys(ctx, user.Id); err != nil {
+		return nil, err
+	}
+	return &grpcLogin.DisableTOTPResponse{}, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user, the old ones stop working
+func (l login) RegenerateRecoveryCodes(ctx context.Context, req *grpcLogin.RegenerateRecoveryCodesRequest) (*grpcLogin.RecoveryCodesResponse, error) {
+	user, _, err := l.tokenUser(ctx, req.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.TotpEnabled() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "two factor authentication is not enabled")
+	}
+
+	if err := l.checkSecondFactor(ctx, user, "", req.Code, ""); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	if _, err := l.updateUser(ctx, &model.User{Id: user.Id, RecoveryCodes: hashes}); err != nil {
+		return nil, err
+	}
+	return &grpcLogin.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// VerifyTOTP exchanges the token login returned and a code or recovery code for the access token
+func (l login) VerifyTOTP(ctx context.Context, req *grpcLogin.VerifyTOTPRequest) (*grpcLogin.LoginResponse, error) {
+	challenge, err := l.checkUserToken(ctx, req.MfaToken, model.UserTokenPurposeLoginTOTP)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := db.NewUserDbManager(l.userCollection).Get(ctx, challenge.UserId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "user of the token not found")
+	}
+	if user.Frozen() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_ACCOUNT_FROZEN)
+	}
+	if !user.TotpEnabled() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "two factor authentication is not enabled")
+	}
+
+	if err := l.checkSecondFactor(ctx, user, req.Ip, req.Code, req.RecoveryCode); err != nil {
+		return nil, err
+	}
+
+	if _, err := l.markUserTokenUsed(ctx, challenge); err != nil {
+		return nil, err
+	}
+	l.loginSucceeded(ctx, loginAttemptKeys(user.Email, req.Ip))
+
+	return l.newSession(ctx, user)
+}
+
+// totpChallenge answers a login of a user with two factor authentication with a token for VerifyTOTP
+func (l login) totpChallenge(ctx context.Context, user *model.User) (*grpcLogin.LoginResponse, error) {
+	token, err := l.createUserToken(ctx, user, model.UserTokenPurposeLoginTOTP, config.GetInt64("totp.challengeExpirySeconds"))
+	if err != nil {
+		logging.Error("failed to create totp challenge", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to start two factor login")
+	}
+
+	return &grpcLogin.LoginResponse{
+		UserId:      user.Id.String(),
+		MfaRequired: true,
+		MfaToken:    token,
+	}, nil
+}
+
+// checkSecondFactor checks a code or recovery code, wrong codes count as failed logins
+func (l login) checkSecondFactor(ctx context.Context, user *model.User, ip string, code string, recoveryCode string) error {
+	reservation, err := l.reserveLoginAttempt(ctx, loginAttemptKeys(user.Email, ip), user.Email, ip)
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	if recoveryCode != "" {
+		ok, err = db.NewUserDbManager(l.userCollection).UseRecoveryCode(ctx, user.Id, hashTokenSecret(normalizeRecoveryCode(recoveryCode)))
+	} else {
+		ok, err = l.useTOTPCode(ctx, user, code)
+	}
+	if err != nil {
+		logging.Error("failed to check second factor", logging.Fields{"userId": user.Id.String(), "error": err.Error()})
+		l.releaseLoginAttempt(ctx, reservation)
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to check code")
+	}
+
+	if !ok {
+		l.loginFailed(ctx, reservation, user.Email, ip, user.Id, model.LoginAuditReasonWrongCode)
+		return grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "invalid code")
+	}
+	l.releaseLoginAttempt(ctx, reservation)
+	return nil
+}
+
+// useTOTPCode checks the code and keeps its time step, a code is refused once it or a later one was used
+func (l login) useTOTPCode(ctx context.Context, user *model.User, code string) (bool, error) {
+	step, ok := totp.Validate(user.TotpSecret, code, time.Now(), config.GetInt64("totp.skewSteps"))
+	if !ok {
+		return false, nil
+	}
+
+	userFilters := map[string]interface{}{}
+	userFilters["totpLastCounter"] = map[string]interface{}{"$not": map[string]interface{}{"$gte": step}}
+	_, err := db.NewUserDbManager(l.userCollection).Update(ctx, &model.User{Id: user.Id, TotpLastCounter: &step}, userFilters)
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// tokenUser returns the user and session of an access token
+func (l login) tokenUser(ctx context.Context, jwt string) (*model.User, id.SessionID, error) {
+	claims, err := l.validClaims(ctx, jwt)
+	if err != nil {
+		return nil, id.SessionID{}, err
+	}
+
+	userId, err := id.ParseUserID(claims.UserId)
+	if err != nil {
+		return nil, id.SessionID{}, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+	}
+	sessionId, err := id.ParseSessionID(claims.SessionId)
+	if err != nil {
+		return nil, id.SessionID{}, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+	}
+
+	user, err := db.NewUserDbManager(l.userCollection).Get(ctx, userId)
+	if err != nil {
+		return nil, id.SessionID{}, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND, err.Error())
+	}
+	return user, sessionId, nil
+}
+
+// newRecoveryCodes returns totp.recoveryCodes codes as they are shown to the user and their hashes as they are stored
+func newRecoveryCodes() ([]string, []string, error) {
+	count := config.GetInt("totp.recoveryCodes")
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		raw := make([]byte, 6)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(raw))
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, hashTokenSecret(code))
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode accepts a recovery code with or without its dash, in any case
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
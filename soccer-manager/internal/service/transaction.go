@@ -2,8 +2,8 @@ package service
 
 import (
 	"context"
-	"math/rand"
 	"protobuf-v1/golang"
+	grpcPlayer "protobuf-v1/golang/player"
 	grpcTxn "protobuf-v1/golang/transaction"
 	"soccer-manager/internal/db"
 	"soccer-manager/internal/model"
@@ -11,6 +11,7 @@ import (
 	grpcError "soccer-manager/util/error"
 	"soccer-manager/util/id"
 	"soccer-manager/util/logging"
+	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -19,10 +20,18 @@ import (
 )
 
 type transaction struct {
-	txnCollection    *mongo.Collection
-	playerCollection *mongo.Collection
-	teamCollection   *mongo.Collection
-	mongoClient      *mongo.Client
+	txnCollection       *mongo.Collection
+	playerCollection    *mongo.Collection
+	teamCollection      *mongo.Collection
+	transferCollection  *mongo.Collection
+	bidCollection       *mongo.Collection
+	offerCollection     *mongo.Collection
+	ledgerCollection    *mongo.Collection
+	historyCollection   *mongo.Collection
+	migrationCollection *mongo.Collection
+	valuation           PlayerValuation
+	calendar            TransferCalendar
+	mongoClient         *mongo.Client
 	grpcTxn.UnimplementedTransactionServiceServer
 }
 
@@ -31,7 +40,11 @@ type createTransactionRequest struct {
 	amount      int64
 	txnType     grpcTxn.TransactionType
 	playerModel *model.Player
+	transfer    *model.Transfer
+	loan        *model.Loan
 	description string
+	// createdAt is only set by backfills, new transactions are stamped when they are written
+	createdAt time.Time
 }
 
 type updatePlayersAndTeamResponse struct {
@@ -40,13 +53,8 @@ type updatePlayersAndTeamResponse struct {
 	newSrcTeam  *model.Team
 }
 
-func NewTransactionService(txnCollection *mongo.Collection, playerCollection *mongo.Collection, teamCollection *mongo.Collection, mongoClient *mongo.Client) grpcTxn.TransactionServiceServer {
-	return transaction{
-		txnCollection:    txnCollection,
-		teamCollection:   teamCollection,
-		playerCollection: playerCollection,
-		mongoClient:      mongoClient,
-	}
+func NewTransactionService(collections Collections, valuation PlayerValuation, calendar TransferCalendar, mongoClient *mongo.Client) grpcTxn.TransactionServiceServer {
+	return newTransaction(collections, valuation, calendar, mongoClient)
 }
 
 func (t transaction) Get(ctx context.Context, req *grpcTxn.GetRequest) (*grpcTxn.Transaction, error) {
@@ -77,15 +85,77 @@ func (t transaction) GetByTeam(ctx context.Context, req *grpcTxn.GetByTeamReques
 	where := map[string]interface{}{}
 	where["teamId"] = teamId
 
-	transactionResp, err := db.NewTransactionDbManager(t.txnCollection).Find(ctx, where)
+	if req.Type != grpcTxn.TransactionType_TT_UNSPECIFIED {
+		where["type"] = req.Type
+	}
+
+	if req.PlayerId != "" {
+		playerId, err := id.ParsePlayerID(req.PlayerId)
+		if err != nil {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+		}
+		where["playerId"] = playerId
+	}
+
+	if req.MinAmount != nil && req.MaxAmount != nil && req.MinAmount.Value > req.MaxAmount.Value {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "min amount can not be greater than max amount")
+	}
+	amountRange := map[string]interface{}{}
+	if req.MinAmount != nil {
+		amountRange["$gte"] = req.MinAmount.Value
+	}
+	if req.MaxAmount != nil {
+		amountRange["$lte"] = req.MaxAmount.Value
+	}
+	if len(amountRange) > 0 {
+		where["amount"] = amountRange
+	}
+
+	if req.CreatedAfter != nil && req.CreatedBefore != nil && !req.CreatedAfter.AsTime().Before(req.CreatedBefore.AsTime()) {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "created after should be before created before")
+	}
+	createdAtRange := map[string]interface{}{}
+	if req.CreatedAfter != nil {
+		createdAtRange["$gte"] = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		createdAtRange["$lt"] = req.CreatedBefore.AsTime()
+	}
+	if len(createdAtRange) > 0 {
+		where["createdAt"] = createdAtRange
+	}
+
+	if req.PageSize < 0 {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "page size can not be negative")
+	}
+
+	page := db.Page{
+		Filters:    where,
+		SortField:  "createdAt",
+		Descending: req.SortOrder != golang.SortOrder_SORT_ORDER_ASC,
+		Limit:      int64(req.PageSize),
+		Cursor:     req.Cursor,
+	}
+	if req.SortBy == grpcTxn.TransactionSortField_TSF_AMOUNT {
+		page.SortField = "amount"
+	}
+
+	transactionResp, nextCursor, err := db.NewTransactionDbManager(t.txnCollection).FindPage(ctx, page)
+	if err != nil {
+		if err == db.ErrInvalidCursor {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, err.Error())
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	total, err := db.NewTransactionDbManager(t.txnCollection).Count(ctx, where)
 	if err != nil {
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
 	}
 
-	transactionsResp := &grpcTxn.Transactions{}
+	transactionsResp := &grpcTxn.Transactions{Total: int32(total), NextCursor: nextCursor}
 	for _, transaction := range transactionResp {
 		transactionsResp.Transactions = append(transactionsResp.Transactions, transaction.ToProto())
-		transactionsResp.Total++
 	}
 	return transactionsResp, nil
 }
@@ -102,6 +172,10 @@ func (t transaction) Buy(ctx context.Context, req *grpcTxn.BuyRequest) (*grpcTxn
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
 	}
 
+	if err := t.calendar.checkOpen(ctx); err != nil {
+		return nil, err
+	}
+
 	//checks - player status, ask value and team budget
 	oldPlayer, err := db.NewPlayerDbManager(t.playerCollection).Get(ctx, playerId)
 	if err != nil {
@@ -115,23 +189,19 @@ func (t transaction) Buy(ctx context.Context, req *grpcTxn.BuyRequest) (*grpcTxn
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "player is not listed")
 	}
 
-	if oldPlayer.TeamId.String() == req.TeamId {
-		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "cannot buy own player")
+	if oldPlayer.ListingType == grpcPlayer.ListingType_LT_LOAN {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "player is listed for loan")
 	}
 
-	destTeam, err := db.NewTeamDbManager(t.teamCollection).Get(ctx, destTeamId)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
-		}
-		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	if oldPlayer.IsAuction() {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "player is listed for auction, place a bid instead")
 	}
 
-	if destTeam.Budget != nil && *destTeam.Budget < *oldPlayer.AskValue {
-		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "not enough budget")
+	if oldPlayer.TeamId.String() == req.TeamId {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_AUTH_ERROR, "cannot buy own player")
 	}
 
-	srcTeam, err := db.NewTeamDbManager(t.teamCollection).Get(ctx, oldPlayer.TeamId)
+	destTeam, err := db.NewTeamDbManager(t.teamCollection).Get(ctx, destTeamId)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
@@ -139,12 +209,11 @@ func (t transaction) Buy(ctx context.Context, req *grpcTxn.BuyRequest) (*grpcTxn
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
 	}
 
-	updatePlayerAndTeamsResp, err := t.updatePlayerAndTeams(ctx, oldPlayer, destTeam, srcTeam)
-	if err != nil {
-		return nil, err
+	if destTeam.Available() < *oldPlayer.AskValue {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "not enough budget")
 	}
 
-	_, destTxn, err := t.createTransactions(ctx, updatePlayerAndTeamsResp.newSrcTeam, updatePlayerAndTeamsResp.newDestTeam, updatePlayerAndTeamsResp.newPlayer, req.Description, *oldPlayer.AskValue)
+	destTxn, err := t.updatePlayerAndTeams(ctx, oldPlayer, destTeam.Id, req.Description)
 	if err != nil {
 		return nil, err
 	}
@@ -152,98 +221,48 @@ func (t transaction) Buy(ctx context.Context, req *grpcTxn.BuyRequest) (*grpcTxn
 	return destTxn.ToProto(), nil
 }
 
-func getNewPlayerValue(value int64) int64 {
-	lo := value + value/10
-	hi := value * 2
-	return rand.Int63n(hi-lo) + lo
-}
-
-func (t transaction) updatePlayerAndTeams(ctx context.Context, oldPlayer *model.Player, destTeam *model.Team, srcTeam *model.Team) (*updatePlayersAndTeamResponse, error) {
-	wc := writeconcern.New(writeconcern.WMajority())
-	rc := readconcern.Snapshot()
-	txnOpts := options.Transaction().SetWriteConcern(wc).SetReadConcern(rc)
-
-	mongoSession, err := t.mongoClient.StartSession()
-	if err != nil {
-		logging.Error("failed to get mongo session", logging.Fields{"error": err.Error()})
-		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
-	}
-	defer mongoSession.EndSession(ctx)
-
-	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
-
-		//update - player status (check old listed, ask value, team and value) (update listed, value, team)
-		playerNewValue := getNewPlayerValue(*oldPlayer.Value)
-		playerNewListed := false
-		playerUpdateModel := &model.Player{
-			Id:       oldPlayer.Id,
-			TeamId:   destTeam.Id,
-			Value:    &playerNewValue,
-			IsListed: &playerNewListed,
+// updatePlayerAndTeams moves the player and writes its transfer and transactions in one mongo transaction
+func (t transaction) updatePlayerAndTeams(ctx context.Context, oldPlayer *model.Player, destTeamId id.TeamID, description string) (*model.Transaction, error) {
+	result, err := runInTransaction(ctx, t.mongoClient, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		//hold - ask value from the buying team, captured when the player is moved
+		if _, err := t.holdBudget(sessionContext, destTeamId, *oldPlayer.AskValue); err != nil {
+			return nil, err
 		}
-		playerFilters := map[string]interface{}{}
-		playerFilters["isListed"] = oldPlayer.IsListed
-		playerFilters["askValue"] = oldPlayer.AskValue
-		playerFilters["teamId"] = oldPlayer.TeamId
-		playerFilters["value"] = oldPlayer.Value
 
-		newPlayer, err := db.NewPlayerDbManager(t.playerCollection).Update(sessionContext, playerUpdateModel, playerFilters)
+		movePlayerResp, err := t.movePlayer(sessionContext, oldPlayer, destTeamId, *oldPlayer.AskValue)
 		if err != nil {
-			logging.Error("failed to update player", logging.Fields{"playerId": oldPlayer.Id.String()})
-			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+			return nil, err
 		}
 
-		//update - new team (check budget, value) (update budget, value)
-		destTeamNewValue := *destTeam.Value + *newPlayer.Value
-		destTeamNewBudget := *destTeam.Budget - *oldPlayer.AskValue
-		destTeamUpdateModel := &model.Team{
-			Id:     destTeam.Id,
-			Value:  &destTeamNewValue,
-			Budget: &destTeamNewBudget,
+		transfer, err := t.createTransfer(sessionContext, movePlayerResp.newSrcTeam, movePlayerResp.newDestTeam, movePlayerResp.newPlayer, *oldPlayer.AskValue)
+		if err != nil {
+			return nil, err
 		}
-		destTeamFilters := map[string]interface{}{}
-		destTeamFilters["value"] = destTeam.Value
-		destTeamFilters["budget"] = destTeam.Budget
 
-		newDestTeam, err := db.NewTeamDbManager(t.teamCollection).Update(sessionContext, destTeamUpdateModel, destTeamFilters)
-		if err != nil {
-			logging.Error("failed to update dest team", logging.Fields{"teamId": destTeam.Id.String()})
-			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		if err := t.createHistoryEntry(sessionContext, oldPlayer, movePlayerResp.newPlayer, transfer); err != nil {
+			return nil, err
 		}
 
-		//update - old team (check value) (update value)
-		srcTeamNewValue := *srcTeam.Value - *oldPlayer.Value
-		srcTeamNewBudget := *srcTeam.Budget + *oldPlayer.AskValue
-		srcTeamUpdateModel := &model.Team{
-			Id:     srcTeam.Id,
-			Value:  &srcTeamNewValue,
-			Budget: &srcTeamNewBudget,
+		if err := t.rejectOpenOffers(sessionContext, oldPlayer.Id); err != nil {
+			return nil, err
 		}
-		srcTeamFilters := map[string]interface{}{}
-		srcTeamFilters["value"] = srcTeam.Value
-		srcTeamFilters["budget"] = srcTeam.Budget
 
-		newSrcTeam, err := db.NewTeamDbManager(t.teamCollection).Update(sessionContext, srcTeamUpdateModel, srcTeamFilters)
+		_, destTxn, err := t.createTransactions(sessionContext, movePlayerResp.newSrcTeam, movePlayerResp.newDestTeam, movePlayerResp.newPlayer, transfer, description, *oldPlayer.AskValue)
 		if err != nil {
-			logging.Error("failed to update src team", logging.Fields{"teamId": srcTeam.Id.String()})
-			return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+			return nil, err
 		}
-
-		return &updatePlayersAndTeamResponse{
-			newPlayer:   newPlayer,
-			newDestTeam: newDestTeam,
-			newSrcTeam:  newSrcTeam,
-		}, nil
-	}
-
-	result, err := mongoSession.WithTransaction(ctx, callback, txnOpts)
+		return destTxn, nil
+	})
 	if err != nil {
 		logging.Error("transaction failed to error", logging.Fields{"error": err.Error()})
+		if grpcError.IsGRPCError(err) {
+			return nil, err
+		}
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "buy failed due to internal error")
 	}
 
-	resp, ok := result.(*updatePlayersAndTeamResponse)
-	if !ok || resp.newPlayer == nil || resp.newSrcTeam == nil || resp.newDestTeam == nil {
+	resp, ok := result.(*model.Transaction)
+	if !ok || resp == nil {
 		logging.Error("transaction returned invalid response")
 		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "buy failed due to internal error")
 	}
@@ -251,7 +270,153 @@ func (t transaction) updatePlayerAndTeams(ctx context.Context, oldPlayer *model.
 	return resp, nil
 }
 
-func (t transaction) createTransactions(ctx context.Context, newSrcTeam *model.Team, newDestTeam *model.Team, newPlayer *model.Player, description string, askValue int64) (*model.Transaction, *model.Transaction, error) {
+// movePlayer moves the player to the dest team, paid from its hold, inside a mongo transaction
+func (t transaction) movePlayer(sessionContext mongo.SessionContext, oldPlayer *model.Player, destTeamId id.TeamID, amount int64) (*updatePlayersAndTeamResponse, error) {
+	if oldPlayer.IsOnLoan() {
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "player is on loan")
+	}
+
+	//update - player status (check old listed, ask value, team and value) (update listed, value, team)
+	playerNewValue, err := t.valuation.Value(sessionContext, oldPlayer, amount)
+	if err != nil {
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	playerNewListed := false
+	//the player signs a new contract with the new team
+	playerNewWage, playerNewContractEndsAt := newContract(playerNewValue)
+	playerUpdateModel := &model.Player{
+		Id:             oldPlayer.Id,
+		TeamId:         destTeamId,
+		Value:          &playerNewValue,
+		IsListed:       &playerNewListed,
+		Wage:           &playerNewWage,
+		ContractEndsAt: &playerNewContractEndsAt,
+	}
+	playerFilters := map[string]interface{}{}
+	playerFilters["isListed"] = oldPlayer.IsListed
+	playerFilters["askValue"] = oldPlayer.AskValue
+	playerFilters["teamId"] = oldPlayer.TeamId
+	playerFilters["value"] = oldPlayer.Value
+
+	newPlayer, err := db.NewPlayerDbManager(t.playerCollection).Update(sessionContext, playerUpdateModel, playerFilters)
+	if err != nil {
+		logging.Error("failed to update player", logging.Fields{"playerId": oldPlayer.Id.String()})
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	//capture - amount held for the new team
+	destTeam, err := t.captureBudget(sessionContext, destTeamId, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	//update - new team (check value) (update value)
+	destTeamNewValue := *destTeam.Value + *newPlayer.Value
+	destTeamUpdateModel := &model.Team{
+		Id:    destTeam.Id,
+		Value: &destTeamNewValue,
+	}
+	destTeamFilters := map[string]interface{}{}
+	destTeamFilters["value"] = destTeam.Value
+
+	newDestTeam, err := db.NewTeamDbManager(t.teamCollection).Update(sessionContext, destTeamUpdateModel, destTeamFilters)
+	if err != nil {
+		logging.Error("failed to update dest team", logging.Fields{"teamId": destTeam.Id.String()})
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	srcTeam, err := db.NewTeamDbManager(t.teamCollection).Get(sessionContext, oldPlayer.TeamId)
+	if err != nil {
+		logging.Error("failed to get src team", logging.Fields{"teamId": oldPlayer.TeamId.String()})
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	//update - old team (check value) (update value)
+	srcTeamNewValue := *srcTeam.Value - *oldPlayer.Value
+	srcTeamNewBudget := *srcTeam.Budget + amount
+	srcTeamUpdateModel := &model.Team{
+		Id:     srcTeam.Id,
+		Value:  &srcTeamNewValue,
+		Budget: &srcTeamNewBudget,
+	}
+	srcTeamFilters := map[string]interface{}{}
+	srcTeamFilters["value"] = srcTeam.Value
+	srcTeamFilters["budget"] = srcTeam.Budget
+
+	newSrcTeam, err := db.NewTeamDbManager(t.teamCollection).Update(sessionContext, srcTeamUpdateModel, srcTeamFilters)
+	if err != nil {
+		logging.Error("failed to update src team", logging.Fields{"teamId": srcTeam.Id.String()})
+		return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	return &updatePlayersAndTeamResponse{
+		newPlayer:   newPlayer,
+		newDestTeam: newDestTeam,
+		newSrcTeam:  newSrcTeam,
+	}, nil
+}
+
+// runInTransaction runs callback inside a mongo transaction with majority writes and snapshot reads
+func runInTransaction(ctx context.Context, mongoClient *mongo.Client, callback func(mongo.SessionContext) (interface{}, error)) (interface{}, error) {
+	wc := writeconcern.New(writeconcern.WMajority())
+	rc := readconcern.Snapshot()
+	txnOpts := options.Transaction().SetWriteConcern(wc).SetReadConcern(rc)
+
+	mongoSession, err := mongoClient.StartSession()
+	if err != nil {
+		logging.Error("failed to get mongo session", logging.Fields{"error": err.Error()})
+		return nil, err
+	}
+	defer mongoSession.EndSession(ctx)
+
+	return mongoSession.WithTransaction(ctx, callback, txnOpts)
+}
+
+func (t transaction) createTransfer(ctx context.Context, newSrcTeam *model.Team, newDestTeam *model.Team, newPlayer *model.Player, askValue int64) (*model.Transfer, error) {
+	transferId, err := id.NewTransferID()
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	transferModel := &model.Transfer{
+		Id:           transferId,
+		PlayerId:     newPlayer.Id,
+		BuyerTeamId:  newDestTeam.Id,
+		SellerTeamId: newSrcTeam.Id,
+		Amount:       askValue,
+		Currency:     newDestTeam.Currency,
+	}
+	transferModel, err = db.NewTransferDbManager(t.transferCollection).Create(ctx, transferModel)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return transferModel, nil
+}
+
+// createHistoryEntry records the player's move to the buying team with the fee paid and the change in value
+func (t transaction) createHistoryEntry(ctx context.Context, oldPlayer *model.Player, newPlayer *model.Player, transfer *model.Transfer) error {
+	historyId, err := id.NewPlayerHistoryID()
+	if err != nil {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	_, err = db.NewPlayerHistoryDbManager(t.historyCollection).Create(ctx, &model.PlayerHistoryEntry{
+		Id:         historyId,
+		PlayerId:   newPlayer.Id,
+		Type:       grpcPlayer.PlayerHistoryType_PHT_TRANSFER,
+		FromTeamId: oldPlayer.TeamId,
+		ToTeamId:   newPlayer.TeamId,
+		TransferId: transfer.Id,
+		Fee:        transfer.Amount,
+		OldValue:   *oldPlayer.Value,
+		NewValue:   *newPlayer.Value,
+		Currency:   transfer.Currency,
+	})
+	if err != nil {
+		return grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+	return nil
+}
+
+func (t transaction) createTransactions(ctx context.Context, newSrcTeam *model.Team, newDestTeam *model.Team, newPlayer *model.Player, transfer *model.Transfer, description string, askValue int64) (*model.Transaction, *model.Transaction, error) {
 
 	// create source transaction
 	srcTxn, err := t.createTransaction(ctx, &createTransactionRequest{
@@ -259,18 +424,29 @@ func (t transaction) createTransactions(ctx context.Context, newSrcTeam *model.T
 		amount:      askValue,
 		txnType:     grpcTxn.TransactionType_TT_SELL,
 		playerModel: newPlayer,
+		transfer:    transfer,
 		description: description,
 	})
 	if err != nil {
 		return nil, nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
 	}
 
+	// post ledger entry - the amount moves from the buying to the selling team's budget
+	_, err = postLedgerEntry(ctx, t.ledgerCollection, transfer.Id, description,
+		teamBudgetPosting(newDestTeam.Id, -askValue),
+		teamBudgetPosting(newSrcTeam.Id, askValue),
+	)
+	if err != nil {
+		return nil, nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
 	// create dest transaction
 	destTxn, err := t.createTransaction(ctx, &createTransactionRequest{
 		teamModel:   newDestTeam,
 		amount:      askValue,
 		txnType:     grpcTxn.TransactionType_TT_BUY,
 		playerModel: newPlayer,
+		transfer:    transfer,
 		description: description,
 	})
 	if err != nil {
@@ -288,13 +464,26 @@ func (t transaction) createTransaction(ctx context.Context, req *createTransacti
 	txnModel := &model.Transaction{
 		Id:          txnId,
 		TeamId:      req.teamModel.Id,
-		PlayerId:    req.playerModel.Id,
-		Title:       string(util.TransactionTypeFromProto[req.txnType]) + " Player",
+		Title:       string(util.TransactionTypeFromProto[req.txnType]),
 		Description: req.description,
 		Amount:      req.amount,
-		Budget:      *req.teamModel.Budget,
 		Type:        req.txnType,
 		Currency:    req.teamModel.Currency,
+		CreatedAt:   req.createdAt,
+	}
+	if req.teamModel.Budget != nil {
+		budget := *req.teamModel.Budget
+		txnModel.Budget = &budget
+	}
+	if req.playerModel != nil {
+		txnModel.PlayerId = req.playerModel.Id
+		txnModel.Title += " Player"
+	}
+	if req.transfer != nil {
+		txnModel.TransferId = req.transfer.Id
+	}
+	if req.loan != nil {
+		txnModel.LoanId = req.loan.Id
 	}
 	txnModel, err = db.NewTransactionDbManager(t.txnCollection).Create(ctx, txnModel)
 	if err != nil {
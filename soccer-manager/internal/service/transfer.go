@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"protobuf-v1/golang"
+	grpcTxn "protobuf-v1/golang/transaction"
+	grpcTransfer "protobuf-v1/golang/transfer"
+	"soccer-manager/internal/db"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type transfer struct {
+	collection *mongo.Collection
+	txnServer  grpcTxn.TransactionServiceServer
+	calendar   TransferCalendar
+	grpcTransfer.UnimplementedTransferServiceServer
+}
+
+func NewTransferService(collection *mongo.Collection, txnServer grpcTxn.TransactionServiceServer, calendar TransferCalendar) grpcTransfer.TransferServiceServer {
+	return transfer{
+		collection: collection,
+		txnServer:  txnServer,
+		calendar:   calendar,
+	}
+}
+
+func (t transfer) Get(ctx context.Context, req *grpcTransfer.GetRequest) (*grpcTransfer.Transfer, error) {
+
+	transferId, err := id.ParseTransferID(req.Id)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ID, err.Error())
+	}
+
+	transferResp, err := db.NewTransferDbManager(t.collection).Get(ctx, transferId)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(ctx, golang.Error_ERROR_NOT_FOUND)
+		}
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+	}
+
+	return transferResp.ToProto(), nil
+}
+
+// Create buys a listed player for the given team; the transfer record itself is written by transaction.Buy
+func (t transfer) Create(ctx context.Context, req *grpcTransfer.CreateRequest) (*grpcTransfer.Transfer, error) {
+
+	txn, err := t.txnServer.Buy(ctx, &grpcTxn.BuyRequest{
+		TeamId:      req.TeamId,
+		PlayerId:    req.PlayerId,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Get(ctx, &grpcTransfer.GetRequest{Id: txn.TransferId})
+}
+
+// GetWindow returns the transfer window open now and the one opening next
+func (t transfer) GetWindow(ctx context.Context, req *grpcTransfer.GetWindowRequest) (*grpcTransfer.GetWindowResponse, error) {
+	now := time.Now()
+	windowResp := &grpcTransfer.GetWindowResponse{
+		IsOpen: t.calendar.IsOpen(now),
+	}
+	if current := t.calendar.Current(now); current != nil {
+		windowResp.Current = current.ToProto()
+	}
+	if next := t.calendar.Next(now); next != nil {
+		windowResp.Next = next.ToProto()
+	}
+	return windowResp, nil
+}
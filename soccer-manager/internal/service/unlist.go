@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"protobuf-v1/golang"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTxn "protobuf-v1/golang/transaction"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/id"
+	"soccer-manager/util/logging"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Unlist takes a player off the transfer market for an admin, a running auction is cancelled
+func (t transaction) Unlist(ctx context.Context, req *grpcTxn.UnlistRequest) (*grpcPlayer.Player, error) {
+	playerId, err := id.ParsePlayerID(req.PlayerId)
+	if err != nil {
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, err.Error())
+	}
+
+	callback := func(sessionContext mongo.SessionContext) (interface{}, error) {
+		player, err := db.NewPlayerDbManager(t.playerCollection).Get(sessionContext, playerId)
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_NOT_FOUND, "player not found")
+		}
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		if player.IsListed == nil || !*player.IsListed {
+			return player, nil
+		}
+
+		where := map[string]interface{}{}
+		where["playerId"] = playerId
+		where["status"] = grpcPlayer.BidStatus_BS_HELD
+		heldBids, err := db.NewBidDbManager(t.bidCollection).Find(sessionContext, where)
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+
+		for _, heldBid := range heldBids {
+			if _, err := t.releaseBudget(sessionContext, heldBid.TeamId, heldBid.Amount); err != nil {
+				return nil, err
+			}
+			_, err = db.NewBidDbManager(t.bidCollection).Update(sessionContext, &model.Bid{Id: heldBid.Id, Status: grpcPlayer.BidStatus_BS_LOST})
+			if err != nil {
+				return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+			}
+		}
+
+		playerNewListed := false
+		playerFilters := map[string]interface{}{}
+		playerFilters["isListed"] = true
+		playerFilters["bidCount"] = player.BidCount
+		newPlayer, err := db.NewPlayerDbManager(t.playerCollection).Update(sessionContext, &model.Player{Id: player.Id, IsListed: &playerNewListed}, playerFilters)
+		if err == mongo.ErrNoDocuments {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INVALID_ARGS, "player changed while unlisting, try again")
+		}
+		if err != nil {
+			return nil, grpcError.NewError(sessionContext, golang.Error_ERROR_INTERNAL_ERROR, err.Error())
+		}
+		return newPlayer, nil
+	}
+
+	result, err := runInTransaction(ctx, t.mongoClient, callback)
+	if err != nil {
+		if grpcError.IsGRPCError(err) {
+			return nil, err
+		}
+		logging.Error("failed to unlist player", logging.Fields{"playerId": playerId.String(), "error": err.Error()})
+		return nil, grpcError.NewError(ctx, golang.Error_ERROR_INTERNAL_ERROR, "unable to unlist player")
+	}
+	return result.(*model.Player).ToProto(), nil
+}
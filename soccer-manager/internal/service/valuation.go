@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	grpcPlayer "protobuf-v1/golang/player"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util"
+	"soccer-manager/util/config"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	ValuationModelRandom   = "random"
+	ValuationModelSeeded   = "seeded"
+	ValuationModelWeighted = "weighted"
+)
+
+// PlayerValuation prices a player when it moves to a new team for the given fee
+type PlayerValuation interface {
+	Value(ctx context.Context, player *model.Player, fee int64) (int64, error)
+}
+
+// NewPlayerValuation returns the valuation model set in valuation.model
+func NewPlayerValuation(historyCollection *mongo.Collection) (PlayerValuation, error) {
+	switch name := config.GetString("valuation.model"); name {
+	case "", ValuationModelRandom:
+		return randomValuation{}, nil
+	case ValuationModelSeeded:
+		return &seededValuation{rand: rand.New(rand.NewSource(config.GetInt64("valuation.seed")))}, nil
+	case ValuationModelWeighted:
+		return newWeightedValuation(historyCollection), nil
+	default:
+		return nil, fmt.Errorf("unknown valuation model %q", name)
+	}
+}
+
+// upliftRange is the range of a random 10-100% increase in value
+func upliftRange(value int64) (int64, int64) {
+	return value + value/10, value * 2
+}
+
+// randomValuation increases the value by a random 10-100%
+type randomValuation struct{}
+
+func (randomValuation) Value(ctx context.Context, player *model.Player, fee int64) (int64, error) {
+	lo, hi := upliftRange(*player.Value)
+	if hi <= lo {
+		return lo, nil
+	}
+	return rand.Int63n(hi-lo) + lo, nil
+}
+
+// seededValuation is randomValuation drawing from a seeded source, so a run can be repeated
+type seededValuation struct {
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+func (s *seededValuation) Value(ctx context.Context, player *model.Player, fee int64) (int64, error) {
+	lo, hi := upliftRange(*player.Value)
+	if hi <= lo {
+		return lo, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rand.Int63n(hi-lo) + lo, nil
+}
+
+// weightedValuation moves the value towards recent fees, then scales it by age, overall rating and position
+type weightedValuation struct {
+	historyCollection *mongo.Collection
+	peakAge           float64
+	ageWeight         float64
+	feeWeight         float64
+	performanceWeight float64
+	recentFees        int
+	typeWeights       map[grpcPlayer.PlayerType]float64
+}
+
+func newWeightedValuation(historyCollection *mongo.Collection) weightedValuation {
+	typeWeights := map[grpcPlayer.PlayerType]float64{}
+	for playerType, name := range util.PlayerTypeFromProto {
+		if name == util.PlayerTypeUnspecified {
+			continue
+		}
+		typeWeights[playerType] = config.GetFloat64("valuation.typeWeights." + string(name))
+	}
+	return weightedValuation{
+		historyCollection: historyCollection,
+		peakAge:           config.GetFloat64("valuation.peakAge"),
+		ageWeight:         config.GetFloat64("valuation.ageWeight"),
+		feeWeight:         config.GetFloat64("valuation.feeWeight"),
+		performanceWeight: config.GetFloat64("valuation.performanceWeight"),
+		recentFees:        config.GetInt("valuation.recentFees"),
+		typeWeights:       typeWeights,
+	}
+}
+
+func (w weightedValuation) Value(ctx context.Context, player *model.Player, fee int64) (int64, error) {
+	fees, err := w.getRecentFees(ctx, player, fee)
+	if err != nil {
+		return 0, err
+	}
+	var feeTotal float64
+	for _, f := range fees {
+		feeTotal += float64(f)
+	}
+	value := (1-w.feeWeight)*float64(*player.Value) + w.feeWeight*feeTotal/float64(len(fees))
+
+	//younger players than the peak age gain, older ones lose
+	if w.peakAge > 0 {
+		value *= 1 + w.ageWeight*(w.peakAge-float64(player.Age))/w.peakAge
+	}
+
+	//players rated above 50 gain, lower rated ones lose
+	if player.Skills != nil && player.Skills.Overall > 0 {
+		value *= 1 + w.performanceWeight*(float64(player.Skills.Overall)-50)/50
+	}
+
+	if typeWeight, ok := w.typeWeights[player.Type]; ok && typeWeight > 0 {
+		value *= typeWeight
+	}
+
+	return int64(math.Max(math.Round(value), 1)), nil
+}
+
+// getRecentFees returns the fee being paid and the fees of the player's latest transfers
+func (w weightedValuation) getRecentFees(ctx context.Context, player *model.Player, fee int64) ([]int64, error) {
+	fees := []int64{fee}
+	if w.recentFees <= 1 {
+		return fees, nil
+	}
+
+	where := map[string]interface{}{}
+	where["playerId"] = player.Id
+	where["type"] = grpcPlayer.PlayerHistoryType_PHT_TRANSFER
+	entries, err := db.NewPlayerHistoryDbManager(w.historyCollection).Find(ctx, where)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(entries) - 1; i >= 0 && len(fees) < w.recentFees; i-- {
+		fees = append(fees, entries[i].Fee)
+	}
+	return fees, nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	grpcPlayer "protobuf-v1/golang/player"
+	"soccer-manager/internal/model"
+	"testing"
+)
+
+func valuationPlayer(value int64) *model.Player {
+	return &model.Player{Value: &value}
+}
+
+func TestRandomValuation(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  int64
+		lo, hi int64
+	}{
+		{name: "increases by 10 to 100 percent", value: 1000000, lo: 1100000, hi: 2000000},
+		{name: "small value", value: 15, lo: 16, hi: 30},
+		{name: "value too small to increase", value: 1, lo: 1, hi: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				got, err := randomValuation{}.Value(context.Background(), valuationPlayer(tt.value), 0)
+				if err != nil {
+					t.Fatalf("Value() error = %v", err)
+				}
+				if got < tt.lo || got > tt.hi {
+					t.Fatalf("Value() = %d, want between %d and %d", got, tt.lo, tt.hi)
+				}
+			}
+		})
+	}
+}
+
+func TestSeededValuation(t *testing.T) {
+	tests := []struct {
+		name   string
+		seed   int64
+		values []int64
+		want   []int64
+	}{
+		{name: "seed 1", seed: 1, values: []int64{1000000, 1000000, 2500000}, want: []int64{1379410, 1753551, 4395821}},
+		{name: "seed 42", seed: 42, values: []int64{1000000, 1000000, 2500000}, want: []int64{1878675, 1956411, 2878760}},
+		{name: "value too small to increase", seed: 1, values: []int64{1}, want: []int64{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valuation := &seededValuation{rand: rand.New(rand.NewSource(tt.seed))}
+			for i, value := range tt.values {
+				got, err := valuation.Value(context.Background(), valuationPlayer(value), 0)
+				if err != nil {
+					t.Fatalf("Value() error = %v", err)
+				}
+				if got != tt.want[i] {
+					t.Errorf("Value() #%d = %d, want %d", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWeightedValuation(t *testing.T) {
+	valuation := weightedValuation{
+		peakAge:           27,
+		ageWeight:         0.5,
+		feeWeight:         0.5,
+		performanceWeight: 0.2,
+		recentFees:        1,
+		typeWeights: map[grpcPlayer.PlayerType]float64{
+			grpcPlayer.PlayerType_PT_GOAL_KEEPER: 0.9,
+			grpcPlayer.PlayerType_PT_ATTACKER:    1.1,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		value      int64
+		fee        int64
+		age        int32
+		overall    int32
+		playerType grpcPlayer.PlayerType
+		want       int64
+	}{
+		{name: "halfway to the fee at the peak age", value: 1000000, fee: 2000000, age: 27, want: 1500000},
+		{name: "younger than the peak age", value: 1000000, fee: 2000000, age: 18, want: 1750000},
+		{name: "older than the peak age", value: 1000000, fee: 2000000, age: 36, want: 1250000},
+		{name: "rated above the middle", value: 1000000, fee: 2000000, age: 27, overall: 80, want: 1680000},
+		{name: "rated below the middle", value: 1000000, fee: 2000000, age: 27, overall: 30, want: 1380000},
+		{name: "attacker", value: 1000000, fee: 2000000, age: 27, playerType: grpcPlayer.PlayerType_PT_ATTACKER, want: 1650000},
+		{name: "goal keeper", value: 1000000, fee: 2000000, age: 27, playerType: grpcPlayer.PlayerType_PT_GOAL_KEEPER, want: 1350000},
+		{name: "all inputs", value: 1000000, fee: 2000000, age: 18, overall: 80, playerType: grpcPlayer.PlayerType_PT_ATTACKER, want: 2156000},
+		{name: "never below one", value: 0, fee: 0, age: 27, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := valuationPlayer(tt.value)
+			player.Age = tt.age
+			player.Type = tt.playerType
+			if tt.overall > 0 {
+				player.Skills = &model.PlayerSkills{Overall: tt.overall}
+			}
+			got, err := valuation.Value(context.Background(), player, tt.fee)
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Value() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
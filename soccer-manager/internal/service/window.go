@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"protobuf-v1/golang"
+	grpcPlayer "protobuf-v1/golang/player"
+	grpcTransfer "protobuf-v1/golang/transfer"
+	"soccer-manager/internal/db"
+	"soccer-manager/internal/model"
+	"soccer-manager/util/config"
+	grpcError "soccer-manager/util/error"
+	"soccer-manager/util/logging"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TransferWindow is a period in which players can be listed, bought, bid and negotiated for
+type TransferWindow struct {
+	OpensAt  time.Time
+	ClosesAt time.Time
+}
+
+func (w TransferWindow) ToProto() *grpcTransfer.TransferWindow {
+	return &grpcTransfer.TransferWindow{
+		OpensAt:  timestamppb.New(w.OpensAt),
+		ClosesAt: timestamppb.New(w.ClosesAt),
+	}
+}
+
+// TransferCalendar holds the transfer windows sorted by opening time, the market is always open when it is empty
+type TransferCalendar []TransferWindow
+
+// NewTransferCalendar reads the windows set in transferWindow.windows
+func NewTransferCalendar() (TransferCalendar, error) {
+	var windows []struct {
+		OpensAt  string `mapstructure:"opensAt"`
+		ClosesAt string `mapstructure:"closesAt"`
+	}
+	if err := config.UnmarshalKey("transferWindow.windows", &windows); err != nil {
+		return nil, err
+	}
+
+	calendar := TransferCalendar{}
+	for i, window := range windows {
+		opensAt, err := time.Parse(time.RFC3339, window.OpensAt)
+		if err != nil {
+			return nil, fmt.Errorf("transfer window %d: invalid opensAt: %w", i, err)
+		}
+		closesAt, err := time.Parse(time.RFC3339, window.ClosesAt)
+		if err != nil {
+			return nil, fmt.Errorf("transfer window %d: invalid closesAt: %w", i, err)
+		}
+		if !opensAt.Before(closesAt) {
+			return nil, fmt.Errorf("transfer window %d: opensAt should be before closesAt", i)
+		}
+		calendar = append(calendar, TransferWindow{OpensAt: opensAt, ClosesAt: closesAt})
+	}
+
+	sort.Slice(calendar, func(i, j int) bool {
+		return calendar[i].OpensAt.Before(calendar[j].OpensAt)
+	})
+	return calendar, nil
+}
+
+// Current returns the window open at the given time, if any
+func (c TransferCalendar) Current(now time.Time) *TransferWindow {
+	for i := range c {
+		if !now.Before(c[i].OpensAt) && now.Before(c[i].ClosesAt) {
+			return &c[i]
+		}
+	}
+	return nil
+}
+
+// Next returns the first window opening after the given time, if any
+func (c TransferCalendar) Next(now time.Time) *TransferWindow {
+	for i := range c {
+		if now.Before(c[i].OpensAt) {
+			return &c[i]
+		}
+	}
+	return nil
+}
+
+func (c TransferCalendar) IsOpen(now time.Time) bool {
+	return len(c) == 0 || c.Current(now) != nil
+}
+
+// checkOpen fails with ERROR_TRANSFER_WINDOW_CLOSED outside a transfer window
+func (c TransferCalendar) checkOpen(ctx context.Context) error {
+	if c.IsOpen(time.Now()) {
+		return nil
+	}
+	message := "transfer window is closed"
+	if next := c.Next(time.Now()); next != nil {
+		message = fmt.Sprintf("transfer window is closed until %s", next.OpensAt.Format(time.RFC3339))
+	}
+	return grpcError.NewError(ctx, golang.Error_ERROR_TRANSFER_WINDOW_CLOSED, message)
+}
+
+// checkClosesAfter fails when an auction would end after the current window closes
+func (c TransferCalendar) checkClosesAfter(ctx context.Context, endsAt time.Time) error {
+	current := c.Current(time.Now())
+	if current == nil || !endsAt.After(current.ClosesAt) {
+		return nil
+	}
+	return grpcError.NewError(ctx, golang.Error_ERROR_INVALID_ARGS, "auction should end before the transfer window closes")
+}
+
+type ListingExpirer interface {
+	UnlistOutsideWindow(context.Context) error
+}
+
+func NewListingExpirer(collections Collections, calendar TransferCalendar) ListingExpirer {
+	return newTransaction(collections, nil, calendar, nil)
+}
+
+// UnlistOutsideWindow takes fixed price and loan listings off the market while no transfer window is open
+func (t transaction) UnlistOutsideWindow(ctx context.Context) error {
+	if t.calendar.IsOpen(time.Now()) {
+		return nil
+	}
+
+	where := map[string]interface{}{}
+	where["isListed"] = true
+	where["listingType"] = map[string]interface{}{"$in": []grpcPlayer.ListingType{grpcPlayer.ListingType_LT_UNSPECIFIED, grpcPlayer.ListingType_LT_FIXED_PRICE, grpcPlayer.ListingType_LT_LOAN}}
+
+	players, err := db.NewPlayerDbManager(t.playerCollection).Find(ctx, where)
+	if err != nil {
+		return err
+	}
+
+	for _, player := range players {
+		playerNewListed := false
+		playerFilters := map[string]interface{}{}
+		playerFilters["isListed"] = true
+		_, err := db.NewPlayerDbManager(t.playerCollection).Update(ctx, &model.Player{Id: player.Id, IsListed: &playerNewListed}, playerFilters)
+		if err != nil && err != mongo.ErrNoDocuments {
+			logging.Error("failed to unlist player", logging.Fields{"playerId": player.Id.String(), "error": err.Error()})
+		}
+	}
+	return nil
+}
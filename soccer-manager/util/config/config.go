@@ -81,3 +81,7 @@ func GetStringMap(key string) map[string]interface{} {
 func GetStringMapString(key string) map[string]string {
 	return viper.GetStringMapString(key)
 }
+
+func UnmarshalKey(key string, rawVal interface{}) error {
+	return viper.UnmarshalKey(key, rawVal)
+}
@@ -25,3 +25,9 @@ func NewError(ctx context.Context, code golang.Error, message ...string) error {
 func GetHttpErrCode(err error) codes.Code {
 	return grpcStatus.Code(err)
 }
+
+// IsGRPCError reports whether err already carries a grpc status
+func IsGRPCError(err error) bool {
+	_, ok := grpcStatus.FromError(err)
+	return ok
+}
@@ -25,6 +25,13 @@ func init() {
 	errorMap[golang.Error_ERROR_NOT_FOUND] = getErrDescription(http.StatusNotFound, "resource not found")
 	errorMap[golang.Error_ERROR_INVALID_ARGS] = getErrDescription(http.StatusBadRequest, "invalid args")
 	errorMap[golang.Error_ERROR_INVALID_ID] = getErrDescription(http.StatusBadRequest, "invalid id")
+	errorMap[golang.Error_ERROR_TRANSFER_WINDOW_CLOSED] = getErrDescription(http.StatusConflict, "transfer window closed")
+	errorMap[golang.Error_ERROR_ALREADY_EXISTS] = getErrDescription(http.StatusConflict, "already exists")
+	errorMap[golang.Error_ERROR_TOKEN_REVOKED] = getErrDescription(http.StatusUnauthorized, "token revoked")
+	errorMap[golang.Error_ERROR_FORBIDDEN] = getErrDescription(http.StatusForbidden, "forbidden")
+	errorMap[golang.Error_ERROR_ACCOUNT_FROZEN] = getErrDescription(http.StatusForbidden, "account frozen")
+	errorMap[golang.Error_ERROR_TOO_MANY_ATTEMPTS] = getErrDescription(http.StatusTooManyRequests, "too many attempts")
+	errorMap[golang.Error_ERROR_RATE_LIMITED] = getErrDescription(http.StatusTooManyRequests, "rate limited")
 }
 
 func getErrDescription(httpCode int32, message string) errDescription {
@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * API key prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type ApiKeyID uuid.UUID
+
+func (id ApiKeyID) Prefix() IDPrefix {
+	return IDPrefixApiKey
+}
+
+func (id ApiKeyID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixApiKey) + id.UUIDString()
+}
+
+func (id ApiKeyID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id ApiKeyID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id ApiKeyID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id ApiKeyID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *ApiKeyID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseApiKeyID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id ApiKeyID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *ApiKeyID) Scan(value interface{}) error {
+	if value == nil {
+		*id = ApiKeyID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = ApiKeyID(uid)
+	return nil
+}
+
+func NewApiKeyID() (ApiKeyID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return ApiKeyID{}, err
+	}
+
+	return ApiKeyID(id), nil
+}
+
+func ParseApiKeyID(id string) (ApiKeyID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return ApiKeyID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixApiKey)) {
+		return ApiKeyID{}, errors.New("invalid api key id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixApiKey)))
+	if err != nil {
+		return ApiKeyID{}, err
+	}
+
+	return ApiKeyID(uid), nil
+}
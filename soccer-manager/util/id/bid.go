@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * Bid prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type BidID uuid.UUID
+
+func (id BidID) Prefix() IDPrefix {
+	return IDPrefixBid
+}
+
+func (id BidID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixBid) + id.UUIDString()
+}
+
+func (id BidID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id BidID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id BidID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id BidID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *BidID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseBidID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id BidID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *BidID) Scan(value interface{}) error {
+	if value == nil {
+		*id = BidID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = BidID(uid)
+	return nil
+}
+
+func NewBidID() (BidID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return BidID{}, err
+	}
+
+	return BidID(id), nil
+}
+
+func ParseBidID(id string) (BidID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return BidID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixBid)) {
+		return BidID{}, errors.New("invalid bid id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixBid)))
+	if err != nil {
+		return BidID{}, err
+	}
+
+	return BidID(uid), nil
+}
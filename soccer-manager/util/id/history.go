@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * Player history prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type PlayerHistoryID uuid.UUID
+
+func (id PlayerHistoryID) Prefix() IDPrefix {
+	return IDPrefixPlayerHistory
+}
+
+func (id PlayerHistoryID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixPlayerHistory) + id.UUIDString()
+}
+
+func (id PlayerHistoryID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id PlayerHistoryID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id PlayerHistoryID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id PlayerHistoryID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *PlayerHistoryID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParsePlayerHistoryID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id PlayerHistoryID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *PlayerHistoryID) Scan(value interface{}) error {
+	if value == nil {
+		*id = PlayerHistoryID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = PlayerHistoryID(uid)
+	return nil
+}
+
+func NewPlayerHistoryID() (PlayerHistoryID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return PlayerHistoryID{}, err
+	}
+
+	return PlayerHistoryID(id), nil
+}
+
+func ParsePlayerHistoryID(id string) (PlayerHistoryID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return PlayerHistoryID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixPlayerHistory)) {
+		return PlayerHistoryID{}, errors.New("invalid player history id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixPlayerHistory)))
+	if err != nil {
+		return PlayerHistoryID{}, err
+	}
+
+	return PlayerHistoryID(uid), nil
+}
@@ -11,6 +11,17 @@ const (
 	IDPrefixUser        = IDPrefix("usr-")
 	IDPrefixPlayer      = IDPrefix("ply-")
 	IDPrefixTransaction = IDPrefix("txn-")
+	IDPrefixTransfer    = IDPrefix("trf-")
+	IDPrefixBid         = IDPrefix("bid-")
+	IDPrefixOffer       = IDPrefix("ofr-")
+	IDPrefixLedgerEntry = IDPrefix("led-")
+	IDPrefixPlayerHistory = IDPrefix("phs-")
+	IDPrefixLoan        = IDPrefix("lon-")
+	IDPrefixSession      = IDPrefix("ses-")
+	IDPrefixRefreshToken = IDPrefix("rft-")
+	IDPrefixUserToken    = IDPrefix("utk-")
+	IDPrefixLoginAudit   = IDPrefix("lau-")
+	IDPrefixApiKey       = IDPrefix("key-")
 )
 
 func (pr IDPrefix) String() string {
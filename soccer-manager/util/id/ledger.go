@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * LedgerEntry prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type LedgerEntryID uuid.UUID
+
+func (id LedgerEntryID) Prefix() IDPrefix {
+	return IDPrefixLedgerEntry
+}
+
+func (id LedgerEntryID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixLedgerEntry) + id.UUIDString()
+}
+
+func (id LedgerEntryID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id LedgerEntryID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id LedgerEntryID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id LedgerEntryID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *LedgerEntryID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseLedgerEntryID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id LedgerEntryID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *LedgerEntryID) Scan(value interface{}) error {
+	if value == nil {
+		*id = LedgerEntryID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = LedgerEntryID(uid)
+	return nil
+}
+
+func NewLedgerEntryID() (LedgerEntryID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return LedgerEntryID{}, err
+	}
+
+	return LedgerEntryID(id), nil
+}
+
+func ParseLedgerEntryID(id string) (LedgerEntryID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return LedgerEntryID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixLedgerEntry)) {
+		return LedgerEntryID{}, errors.New("invalid ledger entry id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixLedgerEntry)))
+	if err != nil {
+		return LedgerEntryID{}, err
+	}
+
+	return LedgerEntryID(uid), nil
+}
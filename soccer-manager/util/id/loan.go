@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * Loan prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type LoanID uuid.UUID
+
+func (id LoanID) Prefix() IDPrefix {
+	return IDPrefixLoan
+}
+
+func (id LoanID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixLoan) + id.UUIDString()
+}
+
+func (id LoanID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id LoanID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id LoanID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id LoanID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *LoanID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseLoanID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id LoanID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *LoanID) Scan(value interface{}) error {
+	if value == nil {
+		*id = LoanID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = LoanID(uid)
+	return nil
+}
+
+func NewLoanID() (LoanID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return LoanID{}, err
+	}
+
+	return LoanID(id), nil
+}
+
+func ParseLoanID(id string) (LoanID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return LoanID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixLoan)) {
+		return LoanID{}, errors.New("invalid loan id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixLoan)))
+	if err != nil {
+		return LoanID{}, err
+	}
+
+	return LoanID(uid), nil
+}
@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * Login audit prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type LoginAuditID uuid.UUID
+
+func (id LoginAuditID) Prefix() IDPrefix {
+	return IDPrefixLoginAudit
+}
+
+func (id LoginAuditID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixLoginAudit) + id.UUIDString()
+}
+
+func (id LoginAuditID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id LoginAuditID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id LoginAuditID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id LoginAuditID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *LoginAuditID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseLoginAuditID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id LoginAuditID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *LoginAuditID) Scan(value interface{}) error {
+	if value == nil {
+		*id = LoginAuditID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = LoginAuditID(uid)
+	return nil
+}
+
+func NewLoginAuditID() (LoginAuditID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return LoginAuditID{}, err
+	}
+
+	return LoginAuditID(id), nil
+}
+
+func ParseLoginAuditID(id string) (LoginAuditID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return LoginAuditID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixLoginAudit)) {
+		return LoginAuditID{}, errors.New("invalid login audit id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixLoginAudit)))
+	if err != nil {
+		return LoginAuditID{}, err
+	}
+
+	return LoginAuditID(uid), nil
+}
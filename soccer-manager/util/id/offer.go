@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * Offer prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type OfferID uuid.UUID
+
+func (id OfferID) Prefix() IDPrefix {
+	return IDPrefixOffer
+}
+
+func (id OfferID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixOffer) + id.UUIDString()
+}
+
+func (id OfferID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id OfferID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id OfferID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id OfferID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *OfferID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseOfferID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id OfferID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *OfferID) Scan(value interface{}) error {
+	if value == nil {
+		*id = OfferID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = OfferID(uid)
+	return nil
+}
+
+func NewOfferID() (OfferID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return OfferID{}, err
+	}
+
+	return OfferID(id), nil
+}
+
+func ParseOfferID(id string) (OfferID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return OfferID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixOffer)) {
+		return OfferID{}, errors.New("invalid offer id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixOffer)))
+	if err != nil {
+		return OfferID{}, err
+	}
+
+	return OfferID(uid), nil
+}
@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * Session prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type SessionID uuid.UUID
+
+func (id SessionID) Prefix() IDPrefix {
+	return IDPrefixSession
+}
+
+func (id SessionID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixSession) + id.UUIDString()
+}
+
+func (id SessionID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id SessionID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id SessionID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id SessionID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *SessionID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseSessionID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id SessionID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *SessionID) Scan(value interface{}) error {
+	if value == nil {
+		*id = SessionID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = SessionID(uid)
+	return nil
+}
+
+func NewSessionID() (SessionID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return SessionID{}, err
+	}
+
+	return SessionID(id), nil
+}
+
+func ParseSessionID(id string) (SessionID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return SessionID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixSession)) {
+		return SessionID{}, errors.New("invalid session id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixSession)))
+	if err != nil {
+		return SessionID{}, err
+	}
+
+	return SessionID(uid), nil
+}
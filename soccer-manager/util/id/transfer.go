@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * Transfer prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type TransferID uuid.UUID
+
+func (id TransferID) Prefix() IDPrefix {
+	return IDPrefixTransfer
+}
+
+func (id TransferID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixTransfer) + id.UUIDString()
+}
+
+func (id TransferID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id TransferID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id TransferID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id TransferID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *TransferID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseTransferID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id TransferID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *TransferID) Scan(value interface{}) error {
+	if value == nil {
+		*id = TransferID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = TransferID(uid)
+	return nil
+}
+
+func NewTransferID() (TransferID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return TransferID{}, err
+	}
+
+	return TransferID(id), nil
+}
+
+func ParseTransferID(id string) (TransferID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return TransferID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixTransfer)) {
+		return TransferID{}, errors.New("invalid transfer id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixTransfer)))
+	if err != nil {
+		return TransferID{}, err
+	}
+
+	return TransferID(uid), nil
+}
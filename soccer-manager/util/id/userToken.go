@@ -0,0 +1,119 @@
+package id
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+/*
+ * User token prefix + uuid will be used internally when passed between services and
+ * removed when passing to/from the database.
+ */
+type UserTokenID uuid.UUID
+
+func (id UserTokenID) Prefix() IDPrefix {
+	return IDPrefixUserToken
+}
+
+func (id UserTokenID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return string(IDPrefixUserToken) + id.UUIDString()
+}
+
+func (id UserTokenID) UUIDString() string {
+	return uuid.UUID(id).String()
+}
+
+func (id UserTokenID) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// Returns empty string when zero for omitempty
+func (id UserTokenID) JSONString() string {
+	if id.IsZero() {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (id UserTokenID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+func (id *UserTokenID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	uid, err := ParseUserTokenID(s)
+	if err != nil {
+		return err
+	}
+
+	*id = uid
+	return nil
+}
+
+// SQL value marshaller
+func (id UserTokenID) Value() (driver.Value, error) {
+	return id.UUIDString(), nil
+}
+
+// SQL scanner
+func (id *UserTokenID) Scan(value interface{}) error {
+	if value == nil {
+		*id = UserTokenID{}
+		return nil
+	}
+
+	val, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("Unable to scan value %v", value)
+	}
+
+	uid, err := uuid.Parse(string(val))
+	if err != nil {
+		return err
+	}
+
+	*id = UserTokenID(uid)
+	return nil
+}
+
+func NewUserTokenID() (UserTokenID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return UserTokenID{}, err
+	}
+
+	return UserTokenID(id), nil
+}
+
+func ParseUserTokenID(id string) (UserTokenID, error) {
+	// Return nil id on empty string
+	if id == "" {
+		return UserTokenID{}, nil
+	}
+
+	// Check prefix
+	if !strings.HasPrefix(id, string(IDPrefixUserToken)) {
+		return UserTokenID{}, errors.New("invalid user token id prefix")
+	}
+
+	// Validate UUID
+	uid, err := uuid.Parse(strings.TrimPrefix(id, string(IDPrefixUserToken)))
+	if err != nil {
+		return UserTokenID{}, err
+	}
+
+	return UserTokenID(uid), nil
+}
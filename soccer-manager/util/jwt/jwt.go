@@ -2,6 +2,7 @@ package jwt
 
 import (
 	"context"
+	"fmt"
 	"protobuf-v1/golang"
 	grpcError "soccer-manager/util/error"
 	"soccer-manager/util/id"
@@ -10,41 +11,64 @@ import (
 	"github.com/dgrijalva/jwt-go"
 )
 
+// Claims of an access token, SessionId is the login the token was issued for and is what gets revoked on logout
 type Claims struct {
-	UserId string `json:"userId"`
-	TeamId string `json:"teamId"`
+	UserId    string `json:"userId"`
+	TeamId    string `json:"teamId"`
+	SessionId string `json:"sessionId"`
+	Role      string `json:"role"`
 	jwt.StandardClaims
 }
 
-func GenerateToken(ctx context.Context, jwtKey string, userId id.UserID, teamId id.TeamID, expirationSeconds int32) (string, error) {
+// GenerateToken signs a token with the signing key of the set, its kid header names the key to verify it with
+func GenerateToken(ctx context.Context, keys *KeySet, userId id.UserID, teamId id.TeamID, sessionId id.SessionID, role string, expirationSeconds int32) (string, error) {
 	expirationTime := time.Now().Add(time.Second * time.Duration(expirationSeconds))
 	claims := &Claims{
-		UserId: userId.String(),
-		TeamId: teamId.String(),
+		UserId:    userId.String(),
+		TeamId:    teamId.String(),
+		SessionId: sessionId.String(),
+		Role:      role,
 		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
 			ExpiresAt: expirationTime.Unix(),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(jwtKey))
+	signing := keys.Signing()
+	token := jwt.NewWithClaims(signing.Method, claims)
+	token.Header["kid"] = signing.Id
+	tokenString, err := token.SignedString(signing.PrivateKey)
 	if err != nil {
 		return tokenString, grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_ERROR, err.Error())
 	}
 	return tokenString, nil
 }
 
-func ParseToken(ctx context.Context, jwtToken string, claims *Claims, jwtKey string) error {
+// ParseToken verifies a token with the key and algorithm its kid names
+func ParseToken(ctx context.Context, jwtToken string, claims *Claims, keys *KeySet) error {
 	token, err := jwt.ParseWithClaims(jwtToken, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(jwtKey), nil
+		kid, _ := token.Header["kid"].(string)
+		key, ok := keys.Get(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+		return key.PublicKey, nil
 	})
 	if err != nil {
-		if err == jwt.ErrSignatureInvalid {
-			return grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+		validationErr, ok := err.(*jwt.ValidationError)
+		if !ok {
+			return grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_ERROR)
+		}
+		// an expired access token is expected, the client refreshes it
+		if validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+			return grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, "token expired")
 		}
-		return grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_ERROR)
+		return grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, validationErr.Error())
 	}
 	if !token.Valid {
-		return grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID, err.Error())
+		return grpcError.NewError(ctx, golang.Error_ERROR_TOKEN_INVALID)
 	}
 
 	return nil
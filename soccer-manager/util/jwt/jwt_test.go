@@ -0,0 +1,261 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"soccer-manager/util/id"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+type testKeys struct {
+	configs    []KeyConfig
+	eddsa      ed25519.PrivateKey
+	rsa        *rsa.PrivateKey
+	retiredRsa *rsa.PrivateKey
+}
+
+// newTestKeys writes an Ed25519 private key, an RSA private key and the public half of a retired RSA key to PEM files
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+	dir := t.TempDir()
+	write := func(name string, blockType string, der []byte) string {
+		file := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", name, err)
+		}
+		return file
+	}
+
+	keys := testKeys{}
+	_, eddsaKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey() error = %v", err)
+	}
+	eddsaDer, err := x509.MarshalPKCS8PrivateKey(eddsaKey)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+	keys.eddsa = eddsaKey
+
+	for _, key := range []**rsa.PrivateKey{&keys.rsa, &keys.retiredRsa} {
+		if *key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			t.Fatalf("rsa.GenerateKey() error = %v", err)
+		}
+	}
+	retiredDer, err := x509.MarshalPKIXPublicKey(&keys.retiredRsa.PublicKey)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey() error = %v", err)
+	}
+
+	keys.configs = []KeyConfig{
+		{Id: "eddsa-1", File: write("eddsa-1.pem", "PRIVATE KEY", eddsaDer)},
+		{Id: "rsa-2", File: write("rsa-2.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(keys.rsa))},
+		{Id: "rsa-1", File: write("rsa-1.pub.pem", "PUBLIC KEY", retiredDer)},
+	}
+	return keys
+}
+
+func TestSigningMethodEdDSA(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey() error = %v", err)
+	}
+	otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey() error = %v", err)
+	}
+
+	signature, err := SigningMethodEdDSA.Sign("header.payload", privateKey)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if err := SigningMethodEdDSA.Verify("header.payload", signature, publicKey); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if err := SigningMethodEdDSA.Verify("header.changed", signature, publicKey); err == nil {
+		t.Fatal("Verify() of a changed signing string error = nil, want an error")
+	}
+	if err := SigningMethodEdDSA.Verify("header.payload", signature, otherPublicKey); err == nil {
+		t.Fatal("Verify() with another key error = nil, want an error")
+	}
+	if _, err := SigningMethodEdDSA.Sign("header.payload", publicKey); err != jwt.ErrInvalidKeyType {
+		t.Fatalf("Sign() with a public key error = %v, want %v", err, jwt.ErrInvalidKeyType)
+	}
+	if got := jwt.GetSigningMethod("EdDSA"); got != SigningMethodEdDSA {
+		t.Fatalf("GetSigningMethod(EdDSA) = %v, want SigningMethodEdDSA", got)
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	keys := newTestKeys(t)
+	keySet, err := LoadKeySet("eddsa-1", keys.configs)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+
+	if got := keySet.Signing().Id; got != "eddsa-1" {
+		t.Fatalf("Signing().Id = %s, want eddsa-1", got)
+	}
+	tests := []struct {
+		kid     string
+		alg     string
+		private bool
+	}{
+		{kid: "eddsa-1", alg: "EdDSA", private: true},
+		{kid: "rsa-2", alg: "RS256", private: true},
+		{kid: "rsa-1", alg: "RS256", private: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kid, func(t *testing.T) {
+			key, ok := keySet.Get(tt.kid)
+			if !ok {
+				t.Fatalf("Get(%s) not found", tt.kid)
+			}
+			if got := key.Method.Alg(); got != tt.alg {
+				t.Fatalf("Method.Alg() = %s, want %s", got, tt.alg)
+			}
+			if got := key.PrivateKey != nil; got != tt.private {
+				t.Fatalf("has private key = %t, want %t", got, tt.private)
+			}
+		})
+	}
+	if _, ok := keySet.Get("unknown"); ok {
+		t.Fatal("Get(unknown) found a key")
+	}
+}
+
+func TestLoadKeySetErrors(t *testing.T) {
+	keys := newTestKeys(t)
+	tests := []struct {
+		name         string
+		signingKeyId string
+		configs      []KeyConfig
+	}{
+		{name: "unknown signing key", signingKeyId: "eddsa-2", configs: keys.configs},
+		{name: "signing key without private key", signingKeyId: "rsa-1", configs: keys.configs},
+		{name: "duplicate kid", signingKeyId: "eddsa-1", configs: append(keys.configs, KeyConfig{Id: "rsa-2", File: keys.configs[1].File})},
+		{name: "missing kid", signingKeyId: "eddsa-1", configs: append(keys.configs, KeyConfig{File: keys.configs[1].File})},
+		{name: "missing file", signingKeyId: "eddsa-1", configs: append(keys.configs, KeyConfig{Id: "rsa-3", File: "missing.pem"})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadKeySet(tt.signingKeyId, tt.configs); err == nil {
+				t.Fatal("LoadKeySet() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestGenerateAndParseToken(t *testing.T) {
+	keys := newTestKeys(t)
+	ctx := context.Background()
+	userId, err := id.NewUserID()
+	if err != nil {
+		t.Fatalf("NewUserID() error = %v", err)
+	}
+
+	for _, signingKeyId := range []string{"eddsa-1", "rsa-2"} {
+		t.Run(signingKeyId, func(t *testing.T) {
+			keySet, err := LoadKeySet(signingKeyId, keys.configs)
+			if err != nil {
+				t.Fatalf("LoadKeySet() error = %v", err)
+			}
+			token, err := GenerateToken(ctx, keySet, userId, id.TeamID{}, id.SessionID{}, "manager", 60)
+			if err != nil {
+				t.Fatalf("GenerateToken() error = %v", err)
+			}
+
+			//tokens stay valid once another key signs
+			verifying, err := LoadKeySet("rsa-2", keys.configs)
+			if err != nil {
+				t.Fatalf("LoadKeySet() error = %v", err)
+			}
+			claims := &Claims{}
+			if err := ParseToken(ctx, token, claims, verifying); err != nil {
+				t.Fatalf("ParseToken() error = %v", err)
+			}
+			if claims.UserId != userId.String() || claims.Role != "manager" {
+				t.Fatalf("ParseToken() claims = %+v, want user %s with role manager", claims, userId)
+			}
+		})
+	}
+}
+
+func TestParseTokenRefused(t *testing.T) {
+	keys := newTestKeys(t)
+	keySet, err := LoadKeySet("eddsa-1", keys.configs)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+	sign := func(method jwt.SigningMethod, kid string, key interface{}) string {
+		token := jwt.NewWithClaims(method, &Claims{UserId: "user"})
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("SignedString() error = %v", err)
+		}
+		return signed
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "alg does not match the kid", token: sign(jwt.SigningMethodRS256, "eddsa-1", keys.rsa)},
+		{name: "hmac with the public key of the kid", token: sign(jwt.SigningMethodHS256, "rsa-2", x509.MarshalPKCS1PublicKey(&keys.rsa.PublicKey))},
+		{name: "signed by another key", token: sign(jwt.SigningMethodRS256, "rsa-1", keys.rsa)},
+		{name: "unknown kid", token: sign(SigningMethodEdDSA, "eddsa-2", keys.eddsa)},
+		{name: "no kid", token: sign(SigningMethodEdDSA, "", keys.eddsa)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ParseToken(context.Background(), tt.token, &Claims{}, keySet); err == nil {
+				t.Fatal("ParseToken() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	keys := newTestKeys(t)
+	keySet, err := LoadKeySet("eddsa-1", keys.configs)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+
+	jwks := keySet.JWKS()
+	if len(jwks.Keys) != 3 {
+		t.Fatalf("JWKS() has %d keys, want 3", len(jwks.Keys))
+	}
+	for i, kid := range []string{"eddsa-1", "rsa-1", "rsa-2"} {
+		if got := jwks.Keys[i].Kid; got != kid {
+			t.Fatalf("JWKS().Keys[%d].Kid = %s, want %s", i, got, kid)
+		}
+		if got := jwks.Keys[i].Use; got != "sig" {
+			t.Fatalf("JWKS().Keys[%d].Use = %s, want sig", i, got)
+		}
+	}
+
+	eddsa := jwks.Keys[0]
+	wantX := base64.RawURLEncoding.EncodeToString(keys.eddsa.Public().(ed25519.PublicKey))
+	if eddsa.Kty != "OKP" || eddsa.Crv != "Ed25519" || eddsa.Alg != "EdDSA" || eddsa.X != wantX || eddsa.N != "" {
+		t.Fatalf("JWKS() eddsa key = %+v, want OKP Ed25519 with x %s", eddsa, wantX)
+	}
+
+	rsaKey := jwks.Keys[2]
+	wantN := base64.RawURLEncoding.EncodeToString(keys.rsa.N.Bytes())
+	if rsaKey.Kty != "RSA" || rsaKey.Alg != "RS256" || rsaKey.N != wantN || rsaKey.E != "AQAB" || rsaKey.X != "" {
+		t.Fatalf("JWKS() rsa key = %+v, want RSA RS256 with n %s and e AQAB", rsaKey, wantN)
+	}
+}
@@ -0,0 +1,166 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sort"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// KeyConfig points to a PEM key file, a public key only verifies tokens
+type KeyConfig struct {
+	Id   string `mapstructure:"id"`
+	File string `mapstructure:"file"`
+}
+
+// Key is a loaded key, its algorithm follows from the key type: RS256 for RSA and EdDSA for Ed25519
+type Key struct {
+	Id         string
+	Method     jwt.SigningMethod
+	PublicKey  crypto.PublicKey
+	PrivateKey crypto.PrivateKey
+}
+
+// KeySet holds every key tokens are verified with, identified by kid, and the one new tokens are signed with
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+// LoadKeySet loads the key files, signingKeyId has to be one of them and hold a private key
+func LoadKeySet(signingKeyId string, configs []KeyConfig) (*KeySet, error) {
+	keySet := &KeySet{keys: map[string]*Key{}}
+	for _, keyConfig := range configs {
+		if keyConfig.Id == "" {
+			return nil, fmt.Errorf("jwt: key %s has no id", keyConfig.File)
+		}
+		if _, ok := keySet.keys[keyConfig.Id]; ok {
+			return nil, fmt.Errorf("jwt: duplicate key id %s", keyConfig.Id)
+		}
+
+		raw, err := ioutil.ReadFile(keyConfig.File)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: key %s: %w", keyConfig.Id, err)
+		}
+		key, err := parseKey(keyConfig.Id, raw)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: key %s: %w", keyConfig.Id, err)
+		}
+		keySet.keys[key.Id] = key
+	}
+
+	signing, ok := keySet.keys[signingKeyId]
+	if !ok {
+		return nil, fmt.Errorf("jwt: signing key %q is not configured", signingKeyId)
+	}
+	if signing.PrivateKey == nil {
+		return nil, fmt.Errorf("jwt: signing key %q has no private key", signingKeyId)
+	}
+	keySet.signing = signing
+	return keySet, nil
+}
+
+func parseKey(kid string, raw []byte) (*Key, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("no PEM data")
+	}
+
+	key := &Key{Id: kid}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key.PrivateKey = parsed
+		if signer, ok := parsed.(crypto.Signer); ok {
+			key.PublicKey = signer.Public()
+		}
+	case "RSA PRIVATE KEY":
+		parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key.PrivateKey = parsed
+		key.PublicKey = parsed.Public()
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key.PublicKey = parsed
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+
+	switch key.PublicKey.(type) {
+	case *rsa.PublicKey:
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, use RSA or Ed25519", key.PublicKey)
+	}
+	return key, nil
+}
+
+// Signing returns the key new tokens are signed with
+func (ks *KeySet) Signing() *Key {
+	return ks.signing
+}
+
+// Get returns the key with the kid, tokens are only verified with the key and algorithm they name
+func (ks *KeySet) Get(kid string) (*Key, bool) {
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// JWK is the public part of a key as published in a JWKS document, RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS is the document served at /.well-known/jwks.json
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys of the set, sorted by kid
+func (ks *KeySet) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	for _, key := range ks.keys {
+		jwk := JWK{Kid: key.Id, Alg: key.Method.Alg(), Use: "sig"}
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].Kid < jwks.Keys[j].Kid
+	})
+	return jwks
+}
@@ -0,0 +1,73 @@
+// Package mailer sends the plain text mails of the account flows.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Message is a plain text mail to one recipient
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(context.Context, Message) error
+}
+
+// Config picks the sender, Driver is one of smtp, file or stdout
+type Config struct {
+	Driver string
+	From   string
+	File   string
+	SMTP   SMTPConfig
+}
+
+// New returns the sender of the driver, stdout when none is set
+func New(cfg Config) (Mailer, error) {
+	if cfg.From == "" {
+		return nil, errors.New("mailer: from address is required")
+	}
+
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTPMailer(cfg.SMTP, cfg.From)
+	case "file":
+		if cfg.File == "" {
+			return nil, errors.New("mailer: file driver needs a file")
+		}
+		return NewFileMailer(cfg.File, cfg.From), nil
+	case "stdout", "":
+		return NewWriterMailer(os.Stdout, cfg.From), nil
+	default:
+		return nil, fmt.Errorf("mailer: unknown driver %q", cfg.Driver)
+	}
+}
+
+// format renders the message with its headers, header values can not add headers
+func format(from string, msg Message) ([]byte, error) {
+	for _, value := range []string{from, msg.To, msg.Subject} {
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, errors.New("mailer: line break in header")
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "From: %s\r\n", from)
+	fmt.Fprintf(buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(buf, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	buf.WriteString("\r\n")
+	return buf.Bytes(), nil
+}
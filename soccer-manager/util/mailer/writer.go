@@ -0,0 +1,69 @@
+package mailer
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+)
+
+// writerMailer prints mails to a writer, one after the other
+type writerMailer struct {
+	mu   *sync.Mutex
+	w    io.Writer
+	from string
+}
+
+func NewWriterMailer(w io.Writer, from string) Mailer {
+	return writerMailer{
+		mu:   &sync.Mutex{},
+		w:    w,
+		from: from,
+	}
+}
+
+func (m writerMailer) Send(ctx context.Context, msg Message) error {
+	raw, err := format(m.from, msg)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err = m.w.Write(append(raw, '\n'))
+	return err
+}
+
+// fileMailer appends mails to a file, which is opened for each mail so it can be truncated or rotated in between
+type fileMailer struct {
+	mu   *sync.Mutex
+	path string
+	from string
+}
+
+func NewFileMailer(path string, from string) Mailer {
+	return fileMailer{
+		mu:   &sync.Mutex{},
+		path: path,
+		from: from,
+	}
+}
+
+func (m fileMailer) Send(ctx context.Context, msg Message) error {
+	raw, err := format(m.from, msg)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	file, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(raw, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,124 @@
+// Package names generates player names and nationalities from embedded datasets, one per locale.
+package names
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path"
+	"sort"
+	"sync"
+)
+
+//go:embed data/*.json
+var dataFS embed.FS
+
+// Locale is the dataset of one nationality, weight sets how often it is picked compared to the others
+type Locale struct {
+	Locale     string   `json:"locale"`
+	Country    string   `json:"country"`
+	Weight     int      `json:"weight"`
+	FirstNames []string `json:"firstNames"`
+	LastNames  []string `json:"lastNames"`
+}
+
+// Person is a generated name with its nationality
+type Person struct {
+	FirstName string
+	LastName  string
+	Country   string
+}
+
+var locales = mustLoadLocales()
+
+func mustLoadLocales() []Locale {
+	files, err := dataFS.ReadDir("data")
+	if err != nil {
+		panic(err)
+	}
+
+	var loaded []Locale
+	for _, file := range files {
+		raw, err := dataFS.ReadFile(path.Join("data", file.Name()))
+		if err != nil {
+			panic(err)
+		}
+		locale := Locale{}
+		if err := json.Unmarshal(raw, &locale); err != nil {
+			panic(fmt.Errorf("names: %s: %w", file.Name(), err))
+		}
+		if locale.Country == "" || locale.Weight <= 0 || len(locale.FirstNames) == 0 || len(locale.LastNames) == 0 {
+			panic(fmt.Errorf("names: %s: incomplete dataset", file.Name()))
+		}
+		loaded = append(loaded, locale)
+	}
+
+	// directory order is not part of the embed contract, sort so a seed always gives the same names
+	sort.Slice(loaded, func(i, j int) bool {
+		return loaded[i].Locale < loaded[j].Locale
+	})
+	return loaded
+}
+
+// Countries returns the nationalities names can be generated for
+func Countries() []string {
+	countries := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		countries = append(countries, locale.Country)
+	}
+	return countries
+}
+
+// Generator draws names, it is safe for concurrent use
+type Generator struct {
+	mu          sync.Mutex
+	rand        *rand.Rand
+	totalWeight int
+}
+
+func NewGenerator(seed int64) *Generator {
+	totalWeight := 0
+	for _, locale := range locales {
+		totalWeight += locale.Weight
+	}
+	return &Generator{
+		rand:        rand.New(rand.NewSource(seed)),
+		totalWeight: totalWeight,
+	}
+}
+
+// Person draws a nationality by weight and a name from its dataset
+func (g *Generator) Person() Person {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	pick := g.rand.Intn(g.totalWeight)
+	for _, locale := range locales {
+		if pick < locale.Weight {
+			return g.person(locale)
+		}
+		pick -= locale.Weight
+	}
+	return g.person(locales[len(locales)-1])
+}
+
+// PersonFrom draws a name of the given nationality
+func (g *Generator) PersonFrom(country string) (Person, error) {
+	for _, locale := range locales {
+		if locale.Country == country {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			return g.person(locale), nil
+		}
+	}
+	return Person{}, fmt.Errorf("names: no dataset for %q", country)
+}
+
+func (g *Generator) person(locale Locale) Person {
+	return Person{
+		FirstName: locale.FirstNames[g.rand.Intn(len(locale.FirstNames))],
+		LastName:  locale.LastNames[g.rand.Intn(len(locale.LastNames))],
+		Country:   locale.Country,
+	}
+}
@@ -0,0 +1,45 @@
+package names
+
+import (
+	"testing"
+)
+
+func TestGeneratorSameSeed(t *testing.T) {
+	tests := []struct {
+		name string
+		seed int64
+	}{
+		{name: "seed 0", seed: 0},
+		{name: "seed 1", seed: 1},
+		{name: "seed 42", seed: 42},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second := NewGenerator(tt.seed), NewGenerator(tt.seed)
+			for i := 0; i < 100; i++ {
+				if got, want := second.Person(), first.Person(); got != want {
+					t.Fatalf("Person() #%d = %+v, want %+v", i, got, want)
+				}
+			}
+			for _, country := range Countries() {
+				want, err := first.PersonFrom(country)
+				if err != nil {
+					t.Fatalf("PersonFrom(%q) error = %v", country, err)
+				}
+				got, err := second.PersonFrom(country)
+				if err != nil {
+					t.Fatalf("PersonFrom(%q) error = %v", country, err)
+				}
+				if got != want {
+					t.Fatalf("PersonFrom(%q) = %+v, want %+v", country, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratorUnknownCountry(t *testing.T) {
+	if _, err := NewGenerator(1).PersonFrom("Atlantis"); err == nil {
+		t.Fatal("PersonFrom() error = nil, want an error")
+	}
+}
@@ -11,8 +11,15 @@ import (
 )
 
 var PathAuthRequired = map[string]bool{
-	"/v1/login": false, // public url
-	"health":    false, // public url
+	"/v1/login":              false, // public url
+	"/v1/register":           false, // public url
+	"/v1/login/totp":         false, // public url, the mfa token of the login response authenticates
+	"/v1/token/refresh":      false, // public url, the access token may have expired
+	"/v1/password/forgot":    false, // public url
+	"/v1/password/reset":     false, // public url, the mailed token authenticates
+	"/v1/email/verify":       false, // public url, the mailed token authenticates
+	"health":                 false, // public url
+	"/.well-known/jwks.json": false, // public url, the keys tokens are verified with
 }
 
 func IsAuthRequired(url string) bool {
@@ -35,7 +42,12 @@ func accessTokenAuthMiddleware(lc grpcLogin.LoginServiceClient) func(http.Handle
 				next.ServeHTTP(w, r)
 				return
 			}
-			mdPairs, err = validateJWT(lc, r)
+			//an api key is taken instead of a bearer token, when both are sent the bearer token wins
+			if r.Header.Get(HeaderApiKey) != "" && r.Header.Get(HeaderAuthorization) == "" {
+				mdPairs, err = validateApiKey(lc, r)
+			} else {
+				mdPairs, err = validateJWT(lc, r)
+			}
 			if err != nil {
 				httpErr := grpcError.NewHttpErrorFromError(r.Method, err)
 				RenderHttpError(w, r, httpErr)
@@ -53,7 +65,7 @@ func validateJWT(lc grpcLogin.LoginServiceClient, r *http.Request) ([]string, er
 	var mdPairs []string
 	authHeader := r.Header.Get(HeaderAuthorization)
 	req := &grpcLogin.ValidateJWTRequest{
-		Jwt: strings.TrimPrefix(strings.ReplaceAll(authHeader, " ", ""), "Bearer"),
+		Jwt: BearerToken(r),
 	}
 
 	resp, err := lc.ValidateJWT(r.Context(), req)
@@ -66,10 +78,16 @@ func validateJWT(lc grpcLogin.LoginServiceClient, r *http.Request) ([]string, er
 		HeaderAuthorization, authHeader,
 		HeaderUserID, resp.UserId,
 		HeaderTeamID, resp.TeamId,
+		HeaderRole, resp.Role,
 	)
 	return mdPairs, nil
 }
 
+// BearerToken returns the access token of the request's authorization header
+func BearerToken(r *http.Request) string {
+	return strings.TrimPrefix(strings.ReplaceAll(r.Header.Get(HeaderAuthorization), " ", ""), "Bearer")
+}
+
 func getContext(ctx context.Context, mdPairs []string) context.Context {
 	md := metadata.Join(MetadataFromIncoming(ctx), metadata.Pairs(mdPairs...))
 	ctx = context.WithValue(ctx, LocalMetadataKey, md)
@@ -0,0 +1,61 @@
+package router
+
+import (
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcLogin "protobuf-v1/golang/login"
+	"soccer-manager/util"
+	grpcError "soccer-manager/util/error"
+	"strings"
+)
+
+// ApiKeyForbiddenPaths manage the account and its credentials and are refused to api keys
+var ApiKeyForbiddenPaths = []string{
+	"/v1/logout",
+	"/v1/password",
+	"/v1/email",
+	"/v1/totp",
+	"/v1/apikeys",
+	"/admin",
+}
+
+func validateApiKey(lc grpcLogin.LoginServiceClient, r *http.Request) ([]string, error) {
+	var mdPairs []string
+	resp, err := lc.ValidateApiKey(r.Context(), &grpcLogin.ValidateApiKeyRequest{Key: strings.TrimSpace(r.Header.Get(HeaderApiKey))})
+	if err != nil {
+		return mdPairs, err
+	}
+
+	if !ApiKeyAllows(r, resp.Scopes) {
+		return mdPairs, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_FORBIDDEN, "api key scopes do not allow this request")
+	}
+
+	mdPairs = append(
+		mdPairs,
+		HeaderUserID, resp.UserId,
+		HeaderTeamID, resp.TeamId,
+		HeaderRole, resp.Role,
+		HeaderApiKeyID, resp.ApiKeyId,
+		HeaderApiKeyScopes, strings.Join(resp.Scopes, ","),
+	)
+	return mdPairs, nil
+}
+
+// ApiKeyAllows tells whether a key with the scopes may make the request
+func ApiKeyAllows(r *http.Request, scopes []string) bool {
+	path := strings.TrimRight(r.URL.Path, "/")
+	for _, forbidden := range ApiKeyForbiddenPaths {
+		if path == forbidden || strings.HasPrefix(path, forbidden+"/") {
+			return false
+		}
+	}
+
+	granted := map[util.ApiKeyScope]bool{}
+	for _, scope := range scopes {
+		granted[util.ApiKeyScope(scope)] = true
+	}
+	if granted[util.ApiKeyScopeTrade] {
+		return true
+	}
+	return granted[util.ApiKeyScopeRead] && (r.Method == http.MethodGet || r.Method == http.MethodHead)
+}
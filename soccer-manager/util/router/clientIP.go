@@ -0,0 +1,26 @@
+package router
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// TrustForwardedFor makes ClientIP take the address a proxy appended to X-Forwarded-For
+var TrustForwardedFor = false
+
+// ClientIP returns the address of the client the request came from
+func ClientIP(r *http.Request) string {
+	if TrustForwardedFor {
+		forwarded := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+		if ip := strings.TrimSpace(forwarded[len(forwarded)-1]); ip != "" {
+			return ip
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
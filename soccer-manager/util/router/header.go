@@ -2,6 +2,7 @@ package router
 
 import (
 	"context"
+	"soccer-manager/util"
 	"soccer-manager/util/id"
 
 	"google.golang.org/grpc/metadata"
@@ -10,6 +11,8 @@ import (
 type Header interface {
 	GetTeamID() id.TeamID
 	GetUserID() id.UserID
+	GetRole() util.Role
+	GetApiKeyID() id.ApiKeyID
 }
 
 type header struct {
@@ -32,6 +35,19 @@ func (h *header) GetTeamID() id.TeamID {
 	return key
 }
 
+func (h *header) GetRole() util.Role {
+	return util.Role(HeaderValueFromIncoming(h.ctx, HeaderRole))
+}
+
+// GetApiKeyID returns the key the request was made with, zero for requests with an access token
+func (h *header) GetApiKeyID() id.ApiKeyID {
+	key, err := id.ParseApiKeyID(HeaderValueFromIncoming(h.ctx, HeaderApiKeyID))
+	if err != nil {
+		return id.ApiKeyID{}
+	}
+	return key
+}
+
 func NewHeader(ctx context.Context) Header {
 	return &header{ctx}
 }
@@ -0,0 +1,57 @@
+package router
+
+import (
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	grpcError "soccer-manager/util/error"
+
+	"github.com/go-chi/chi"
+)
+
+// TeamLookup returns the ids of the teams the resource a request is for belongs to
+type TeamLookup func(r *http.Request) ([]string, error)
+
+// RequireTeam only lets a request through when its team is one of the teams the lookup returns
+func RequireTeam(lookup TeamLookup) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			teamIds, err := lookup(r)
+			if err != nil {
+				RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, err))
+				return
+			}
+
+			headerTeamId := NewHeader(r.Context()).GetTeamID()
+			for _, teamId := range teamIds {
+				if !headerTeamId.IsZero() && teamId == headerTeamId.String() {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// RequireTeamOwner is RequireTeam for the routes of a team, the team is the one in the route param
+func RequireTeamOwner(param string) func(http.Handler) http.Handler {
+	return RequireTeam(func(r *http.Request) ([]string, error) {
+		return []string{chi.URLParam(r, param)}, nil
+	})
+}
+
+// RequireUser only lets a request through when the user of its access token is the one in the route param
+func RequireUser(param string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			headerUserId := NewHeader(r.Context()).GetUserID()
+			if headerUserId.IsZero() || chi.URLParam(r, param) != headerUserId.String() {
+				RenderHttpError(w, r, grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_AUTH_ERROR)))
+				return
+			}
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
@@ -0,0 +1,49 @@
+package router
+
+import (
+	"net/http"
+	grpcRoot "protobuf-v1/golang"
+	"soccer-manager/util"
+	grpcError "soccer-manager/util/error"
+)
+
+type Permission string
+
+const (
+	PermissionAdminRead  = Permission("admin:read")
+	PermissionAdminWrite = Permission("admin:write")
+)
+
+// RolePermissions lists what each role may do beyond managing its own team, managers have no extra permission
+var RolePermissions = map[util.Role][]Permission{
+	util.RoleManager: {},
+	util.RoleSupport: {PermissionAdminRead},
+	util.RoleAdmin:   {PermissionAdminRead, PermissionAdminWrite},
+}
+
+func HasPermission(role util.Role, permission Permission) bool {
+	for _, granted := range RolePermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// RequirePermission only lets a request through when its role has every one of the permissions
+func RequirePermission(permissions ...Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			role := NewHeader(r.Context()).GetRole()
+			for _, permission := range permissions {
+				if !HasPermission(role, permission) {
+					httpErr := grpcError.NewHttpErrorFromError(r.Method, grpcError.NewError(r.Context(), grpcRoot.Error_ERROR_FORBIDDEN, "missing permission "+string(permission)))
+					RenderHttpError(w, r, httpErr)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
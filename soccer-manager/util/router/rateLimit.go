@@ -0,0 +1,159 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"path"
+	grpcRoot "protobuf-v1/golang"
+	"soccer-manager/util/logging"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+	HeaderRetryAfter         = "Retry-After"
+)
+
+// RateLimit is a token bucket of Requests tokens refilled over PeriodSeconds, zero requests means no limit
+type RateLimit struct {
+	Requests      int   `mapstructure:"requests"`
+	PeriodSeconds int64 `mapstructure:"periodSeconds"`
+}
+
+func (l RateLimit) period() time.Duration {
+	return time.Duration(l.PeriodSeconds) * time.Second
+}
+
+// RateLimitRule gives the routes matching the method and path.Match pattern their own bucket
+type RateLimitRule struct {
+	Method    string `mapstructure:"method"`
+	Path      string `mapstructure:"path"`
+	RateLimit `mapstructure:",squash"`
+}
+
+func (rule RateLimitRule) matches(r *http.Request) bool {
+	if rule.Method != "" && !strings.EqualFold(rule.Method, r.Method) {
+		return false
+	}
+	matched, err := path.Match(strings.TrimRight(rule.Path, "/"), strings.TrimRight(r.URL.Path, "/"))
+	return err == nil && matched
+}
+
+// RateLimitConfig is the rateLimit block of the gateway config, the first matching rule wins
+type RateLimitConfig struct {
+	Enabled bool            `mapstructure:"enabled"`
+	Store   string          `mapstructure:"store"`
+	Ip      RateLimit       `mapstructure:"ip"`
+	Default RateLimit       `mapstructure:"default"`
+	Routes  []RateLimitRule `mapstructure:"routes"`
+}
+
+// RateLimitResult is the state of a bucket after taking a token from it
+type RateLimitResult struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is how long until the next token, zero when the request was allowed
+	RetryAfter time.Duration
+	// ResetAfter is how long until the bucket is full again
+	ResetAfter time.Duration
+}
+
+// RateLimitStore keeps the buckets
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit RateLimit, now time.Time) (RateLimitResult, error)
+}
+
+// RateLimitIpMiddleware limits requests per client ip before the access token is checked
+func RateLimitIpMiddleware(store RateLimitStore, conf RateLimitConfig) func(http.Handler) http.Handler {
+	return conf.middleware(store, func(r *http.Request) (string, RateLimit) {
+		if _, limit := conf.limitFor(r); limit.Requests <= 0 {
+			return "", limit
+		}
+		return "ip|" + ClientIP(r), conf.Ip
+	})
+}
+
+// RateLimitMiddleware limits requests per api key, else user, else ip
+func RateLimitMiddleware(store RateLimitStore, conf RateLimitConfig) func(http.Handler) http.Handler {
+	return conf.middleware(store, func(r *http.Request) (string, RateLimit) {
+		scope, limit := conf.limitFor(r)
+		return scope + "|" + rateLimitClient(r), limit
+	})
+}
+
+// middleware takes a token from the bucket the key function names for the request
+func (conf RateLimitConfig) middleware(store RateLimitStore, keyFor func(r *http.Request) (string, RateLimit)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !conf.Enabled {
+			return next
+		}
+
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			key, limit := keyFor(r)
+			if limit.Requests <= 0 || limit.PeriodSeconds <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			result, err := store.Take(r.Context(), key, limit, time.Now())
+			if err != nil {
+				logging.Error("failed to take rate limit token", logging.Fields{"key": key, "error": err.Error()})
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set(HeaderRateLimitLimit, strconv.Itoa(limit.Requests))
+			w.Header().Set(HeaderRateLimitRemaining, strconv.Itoa(result.Remaining))
+			w.Header().Set(HeaderRateLimitReset, strconv.FormatInt(ceilSeconds(result.ResetAfter), 10))
+			w.Header().Set(HeaderRateLimitPolicy, fmt.Sprintf("%d;w=%d", limit.Requests, limit.PeriodSeconds))
+
+			if !result.Allowed {
+				retryAfter := ceilSeconds(result.RetryAfter)
+				w.Header().Set(HeaderRetryAfter, strconv.FormatInt(retryAfter, 10))
+				RenderJSON(Response{
+					Writer: w,
+					Status: http.StatusTooManyRequests,
+					GRPCData: &grpcRoot.HttpError{
+						Code:    grpcRoot.Error_ERROR_RATE_LIMITED,
+						Message: fmt.Sprintf("rate limit exceeded, retry in %d seconds", retryAfter),
+					},
+				})
+				return
+			}
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// limitFor returns the bucket scope and the limit of the request
+func (conf RateLimitConfig) limitFor(r *http.Request) (string, RateLimit) {
+	for _, rule := range conf.Routes {
+		if rule.matches(r) {
+			return strings.ToUpper(rule.Method) + " " + rule.Path, rule.RateLimit
+		}
+	}
+	return "default", conf.Default
+}
+
+func rateLimitClient(r *http.Request) string {
+	h := NewHeader(r.Context())
+	if keyId := h.GetApiKeyID(); !keyId.IsZero() {
+		return "key:" + keyId.String()
+	}
+	if userId := h.GetUserID(); !userId.IsZero() {
+		return "user:" + userId.String()
+	}
+	return "ip:" + ClientIP(r)
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
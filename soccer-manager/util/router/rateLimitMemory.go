@@ -0,0 +1,78 @@
+package router
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+type rateLimitBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	fullAt    time.Time
+}
+
+type rateLimitMemory struct {
+	mu      sync.Mutex
+	buckets map[string]*rateLimitBucket
+	sweptAt time.Time
+}
+
+const rateLimitSweepInterval = time.Minute
+
+// NewRateLimitMemoryStore keeps the buckets in the gateway process, limits are per gateway and reset on restart
+func NewRateLimitMemoryStore() RateLimitStore {
+	return &rateLimitMemory{
+		buckets: map[string]*rateLimitBucket{},
+	}
+}
+
+func (m *rateLimitMemory) Take(ctx context.Context, key string, limit RateLimit, now time.Time) (RateLimitResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	capacity := float64(limit.Requests)
+	perToken := limit.period() / time.Duration(limit.Requests)
+
+	bucket, ok := m.buckets[key]
+	if !ok {
+		bucket = &rateLimitBucket{tokens: capacity, updatedAt: now}
+		m.buckets[key] = bucket
+	}
+	if elapsed := now.Sub(bucket.updatedAt); elapsed > 0 {
+		bucket.tokens = math.Min(capacity, bucket.tokens+float64(elapsed)/float64(perToken))
+		bucket.updatedAt = now
+	}
+	// a limit lowered in the config applies right away
+	if bucket.tokens > capacity {
+		bucket.tokens = capacity
+	}
+
+	result := RateLimitResult{}
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - bucket.tokens) * float64(perToken))
+	}
+	result.Remaining = int(bucket.tokens)
+	result.ResetAfter = time.Duration((capacity - bucket.tokens) * float64(perToken))
+	bucket.fullAt = now.Add(result.ResetAfter)
+
+	m.dropFull(now)
+	return result, nil
+}
+
+// dropFull forgets the buckets that have refilled, a new bucket starts full anyway. Callers hold the lock
+func (m *rateLimitMemory) dropFull(now time.Time) {
+	if now.Sub(m.sweptAt) < rateLimitSweepInterval {
+		return
+	}
+	m.sweptAt = now
+	for key, bucket := range m.buckets {
+		if !bucket.fullAt.After(now) {
+			delete(m.buckets, key)
+		}
+	}
+}
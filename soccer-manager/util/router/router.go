@@ -21,6 +21,10 @@ const (
 	HeaderAuthorization = "authorization"
 	HeaderUserID        = "sd-user-id"
 	HeaderTeamID        = "sd-team-id"
+	HeaderRole          = "sd-role"
+	HeaderApiKey        = "x-api-key"
+	HeaderApiKeyID      = "sd-api-key-id"
+	HeaderApiKeyScopes  = "sd-api-key-scopes"
 
 	defaultCertLocation = "./ssl/cert.pem"
 	defaultKeyLocation  = "./ssl/key.pem"
@@ -61,7 +65,8 @@ const gzipPerf = 5
 
 var gzipMime = []string{"application/json", "text/html", "text/css", "text/plain", "application/pdf", "application/csv"}
 
-func NewApiRouter(lc grpcLogin.LoginServiceClient) Router {
+// NewApiRouter returns the gateway router, beforeAuth runs before the access token is checked
+func NewApiRouter(lc grpcLogin.LoginServiceClient, beforeAuth []func(http.Handler) http.Handler, middlewares ...func(http.Handler) http.Handler) Router {
 	rchi := chi.NewRouter()
 
 	compressor := chimw.NewCompressor(gzipPerf, gzipMime...)
@@ -76,10 +81,10 @@ func NewApiRouter(lc grpcLogin.LoginServiceClient) Router {
 		MaxAge:           300, 
 	}))
 
-	rchi.Use(
-		apmchi.Middleware(),
-		accessTokenAuthMiddleware(lc),
-	)
+	rchi.Use(apmchi.Middleware())
+	rchi.Use(beforeAuth...)
+	rchi.Use(accessTokenAuthMiddleware(lc))
+	rchi.Use(middlewares...)
 
 	return router{
 		chi: rchi,
@@ -0,0 +1,84 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 seed of the RFC 6238 test vectors, base32 encoded
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	// the RFC lists eight digit codes, six digit codes are their last six digits
+	tests := []struct {
+		name    string
+		time    int64
+		counter int64
+		code    string
+	}{
+		{name: "59", time: 59, counter: 1, code: "287082"},
+		{name: "1111111109", time: 1111111109, counter: 37037036, code: "081804"},
+		{name: "1111111111", time: 1111111111, counter: 37037037, code: "050471"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Counter(time.Unix(tt.time, 0)); got != tt.counter {
+				t.Fatalf("Counter() = %d, want %d", got, tt.counter)
+			}
+			got, err := Code(rfcSecret, tt.counter)
+			if err != nil {
+				t.Fatalf("Code() error = %v", err)
+			}
+			if got != tt.code {
+				t.Fatalf("Code() = %s, want %s", got, tt.code)
+			}
+		})
+	}
+}
+
+func TestCodeInvalidSecret(t *testing.T) {
+	if _, err := Code("not base32!", 1); err == nil {
+		t.Fatal("Code() error = nil, want an error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	tests := []struct {
+		name string
+		code string
+		skew int64
+		step int64
+		ok   bool
+	}{
+		{name: "current step", code: "050471", skew: 0, step: 37037037, ok: true},
+		{name: "previous step without skew", code: "081804", skew: 0},
+		{name: "previous step within skew", code: "081804", skew: 1, step: 37037036, ok: true},
+		{name: "spaces are ignored", code: " 050 471 ", skew: 0, step: 37037037, ok: true},
+		{name: "step outside skew", code: "287082", skew: 1},
+		{name: "wrong code", code: "123456", skew: 1},
+		{name: "too short", code: "05047", skew: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(rfcSecret, tt.code, now, tt.skew)
+			if ok != tt.ok || step != tt.step {
+				t.Fatalf("Validate() = %d, %t, want %d, %t", step, ok, tt.step, tt.ok)
+			}
+		})
+	}
+}
+
+func TestValidateNextStep(t *testing.T) {
+	code, err := Code(rfcSecret, 37037038)
+	if err != nil {
+		t.Fatalf("Code() error = %v", err)
+	}
+	now := time.Unix(1111111111, 0)
+	if _, ok := Validate(rfcSecret, code, now, 0); ok {
+		t.Fatal("Validate() of the next step without skew = true, want false")
+	}
+	if step, ok := Validate(rfcSecret, code, now, 1); !ok || step != 37037038 {
+		t.Fatalf("Validate() of the next step within skew = %d, %t, want 37037038, true", step, ok)
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"errors"
 	"fmt"
 	grpcRoot "protobuf-v1/golang"
+	grpcLoan "protobuf-v1/golang/loan"
+	grpcOffer "protobuf-v1/golang/offer"
 	grpcPlayer "protobuf-v1/golang/player"
 	grpcTxn "protobuf-v1/golang/transaction"
+	grpcUser "protobuf-v1/golang/user"
 	"regexp"
 	"soccer-manager/util/config"
 	"strconv"
@@ -111,12 +114,55 @@ const (
 	TransactionTypeUnspecified = TransactionType("")
 	TransactionTypeBuy         = TransactionType("Buy")
 	TransactionTypeSell        = TransactionType("Sell")
+	TransactionTypeLoanIn      = TransactionType("LoanIn")
+	TransactionTypeLoanOut     = TransactionType("LoanOut")
+	TransactionTypeWage        = TransactionType("Wage")
 )
 
 var TransactionTypeFromProto = map[grpcTxn.TransactionType]TransactionType{
 	grpcTxn.TransactionType_TT_UNSPECIFIED: TransactionTypeUnspecified,
 	grpcTxn.TransactionType_TT_BUY:         TransactionTypeBuy,
 	grpcTxn.TransactionType_TT_SELL:        TransactionTypeSell,
+	grpcTxn.TransactionType_TT_LOAN_IN:     TransactionTypeLoanIn,
+	grpcTxn.TransactionType_TT_LOAN_OUT:    TransactionTypeLoanOut,
+	grpcTxn.TransactionType_TT_WAGE:        TransactionTypeWage,
+}
+
+var TransactionTypeToProto = map[TransactionType]grpcTxn.TransactionType{
+	TransactionTypeUnspecified: grpcTxn.TransactionType_TT_UNSPECIFIED,
+	TransactionTypeBuy:         grpcTxn.TransactionType_TT_BUY,
+	TransactionTypeSell:        grpcTxn.TransactionType_TT_SELL,
+	TransactionTypeLoanIn:      grpcTxn.TransactionType_TT_LOAN_IN,
+	TransactionTypeLoanOut:     grpcTxn.TransactionType_TT_LOAN_OUT,
+	TransactionTypeWage:        grpcTxn.TransactionType_TT_WAGE,
+}
+
+type TransactionSortField string
+
+const (
+	TransactionSortFieldUnspecified = TransactionSortField("")
+	TransactionSortFieldCreatedAt   = TransactionSortField("createdAt")
+	TransactionSortFieldAmount      = TransactionSortField("amount")
+)
+
+var TransactionSortFieldToProto = map[TransactionSortField]grpcTxn.TransactionSortField{
+	TransactionSortFieldUnspecified: grpcTxn.TransactionSortField_TSF_UNSPECIFIED,
+	TransactionSortFieldCreatedAt:   grpcTxn.TransactionSortField_TSF_CREATED_AT,
+	TransactionSortFieldAmount:      grpcTxn.TransactionSortField_TSF_AMOUNT,
+}
+
+type SortOrder string
+
+const (
+	SortOrderUnspecified = SortOrder("")
+	SortOrderAsc         = SortOrder("asc")
+	SortOrderDesc        = SortOrder("desc")
+)
+
+var SortOrderToProto = map[SortOrder]grpcRoot.SortOrder{
+	SortOrderUnspecified: grpcRoot.SortOrder_SORT_ORDER_UNSPECIFIED,
+	SortOrderAsc:         grpcRoot.SortOrder_SORT_ORDER_ASC,
+	SortOrderDesc:        grpcRoot.SortOrder_SORT_ORDER_DESC,
 }
 
 type PlayerType string
@@ -136,3 +182,182 @@ var PlayerTypeFromProto = map[grpcPlayer.PlayerType]PlayerType{
 	grpcPlayer.PlayerType_PT_MID_FIELDER: PlayerTypeMidFielder,
 	grpcPlayer.PlayerType_PT_ATTACKER:    PlayerTypeAttacker,
 }
+
+var PlayerTypeToProto = map[PlayerType]grpcPlayer.PlayerType{
+	PlayerTypeUnspecified: grpcPlayer.PlayerType_PT_UNSPECIFIED,
+	PlayerTypeGoalKeeper:  grpcPlayer.PlayerType_PT_GOAL_KEEPER,
+	PlayerTypeDefender:    grpcPlayer.PlayerType_PT_DEFENDER,
+	PlayerTypeMidFielder:  grpcPlayer.PlayerType_PT_MID_FIELDER,
+	PlayerTypeAttacker:    grpcPlayer.PlayerType_PT_ATTACKER,
+}
+
+type PlayerSortField string
+
+const (
+	PlayerSortFieldUnspecified = PlayerSortField("")
+	PlayerSortFieldAskValue    = PlayerSortField("askValue")
+	PlayerSortFieldValue       = PlayerSortField("value")
+	PlayerSortFieldAge         = PlayerSortField("age")
+	PlayerSortFieldOverall     = PlayerSortField("overall")
+)
+
+var PlayerSortFieldToProto = map[PlayerSortField]grpcPlayer.PlayerSortField{
+	PlayerSortFieldUnspecified: grpcPlayer.PlayerSortField_PSF_UNSPECIFIED,
+	PlayerSortFieldAskValue:    grpcPlayer.PlayerSortField_PSF_ASK_VALUE,
+	PlayerSortFieldValue:       grpcPlayer.PlayerSortField_PSF_VALUE,
+	PlayerSortFieldAge:         grpcPlayer.PlayerSortField_PSF_AGE,
+	PlayerSortFieldOverall:     grpcPlayer.PlayerSortField_PSF_OVERALL,
+}
+
+type ListingType string
+
+const (
+	ListingTypeUnspecified   = ListingType("")
+	ListingTypeFixedPrice    = ListingType("fixedPrice")
+	ListingTypeOpenAuction   = ListingType("openAuction")
+	ListingTypeSealedAuction = ListingType("sealedAuction")
+	ListingTypeLoan          = ListingType("loan")
+)
+
+var ListingTypeFromProto = map[grpcPlayer.ListingType]ListingType{
+	grpcPlayer.ListingType_LT_UNSPECIFIED:    ListingTypeUnspecified,
+	grpcPlayer.ListingType_LT_FIXED_PRICE:    ListingTypeFixedPrice,
+	grpcPlayer.ListingType_LT_OPEN_AUCTION:   ListingTypeOpenAuction,
+	grpcPlayer.ListingType_LT_SEALED_AUCTION: ListingTypeSealedAuction,
+	grpcPlayer.ListingType_LT_LOAN:           ListingTypeLoan,
+}
+
+var ListingTypeToProto = map[ListingType]grpcPlayer.ListingType{
+	ListingTypeUnspecified:   grpcPlayer.ListingType_LT_UNSPECIFIED,
+	ListingTypeFixedPrice:    grpcPlayer.ListingType_LT_FIXED_PRICE,
+	ListingTypeOpenAuction:   grpcPlayer.ListingType_LT_OPEN_AUCTION,
+	ListingTypeSealedAuction: grpcPlayer.ListingType_LT_SEALED_AUCTION,
+	ListingTypeLoan:          grpcPlayer.ListingType_LT_LOAN,
+}
+
+type PlayerHistoryType string
+
+const (
+	PlayerHistoryTypeUnspecified     = PlayerHistoryType("")
+	PlayerHistoryTypeJoined          = PlayerHistoryType("joined")
+	PlayerHistoryTypeTransfer        = PlayerHistoryType("transfer")
+	PlayerHistoryTypeValueChange     = PlayerHistoryType("valueChange")
+	PlayerHistoryTypeLoan            = PlayerHistoryType("loan")
+	PlayerHistoryTypeLoanReturn      = PlayerHistoryType("loanReturn")
+	PlayerHistoryTypeContractRenewal = PlayerHistoryType("contractRenewal")
+)
+
+var PlayerHistoryTypeFromProto = map[grpcPlayer.PlayerHistoryType]PlayerHistoryType{
+	grpcPlayer.PlayerHistoryType_PHT_UNSPECIFIED:      PlayerHistoryTypeUnspecified,
+	grpcPlayer.PlayerHistoryType_PHT_JOINED:           PlayerHistoryTypeJoined,
+	grpcPlayer.PlayerHistoryType_PHT_TRANSFER:         PlayerHistoryTypeTransfer,
+	grpcPlayer.PlayerHistoryType_PHT_VALUE_CHANGE:     PlayerHistoryTypeValueChange,
+	grpcPlayer.PlayerHistoryType_PHT_LOAN:             PlayerHistoryTypeLoan,
+	grpcPlayer.PlayerHistoryType_PHT_LOAN_RETURN:      PlayerHistoryTypeLoanReturn,
+	grpcPlayer.PlayerHistoryType_PHT_CONTRACT_RENEWAL: PlayerHistoryTypeContractRenewal,
+}
+
+type BidStatus string
+
+const (
+	BidStatusUnspecified = BidStatus("")
+	BidStatusHeld        = BidStatus("held")
+	BidStatusWon         = BidStatus("won")
+	BidStatusLost        = BidStatus("lost")
+	BidStatusOutbid      = BidStatus("outbid")
+)
+
+var BidStatusFromProto = map[grpcPlayer.BidStatus]BidStatus{
+	grpcPlayer.BidStatus_BS_UNSPECIFIED: BidStatusUnspecified,
+	grpcPlayer.BidStatus_BS_HELD:        BidStatusHeld,
+	grpcPlayer.BidStatus_BS_WON:         BidStatusWon,
+	grpcPlayer.BidStatus_BS_LOST:        BidStatusLost,
+	grpcPlayer.BidStatus_BS_OUTBID:      BidStatusOutbid,
+}
+
+type OfferStatus string
+
+const (
+	OfferStatusUnspecified = OfferStatus("")
+	OfferStatusPending     = OfferStatus("pending")
+	OfferStatusCountered   = OfferStatus("countered")
+	OfferStatusAccepted    = OfferStatus("accepted")
+	OfferStatusRejected    = OfferStatus("rejected")
+	OfferStatusWithdrawn   = OfferStatus("withdrawn")
+	OfferStatusExpired     = OfferStatus("expired")
+)
+
+var OfferStatusFromProto = map[grpcOffer.OfferStatus]OfferStatus{
+	grpcOffer.OfferStatus_OS_UNSPECIFIED: OfferStatusUnspecified,
+	grpcOffer.OfferStatus_OS_PENDING:     OfferStatusPending,
+	grpcOffer.OfferStatus_OS_COUNTERED:   OfferStatusCountered,
+	grpcOffer.OfferStatus_OS_ACCEPTED:    OfferStatusAccepted,
+	grpcOffer.OfferStatus_OS_REJECTED:    OfferStatusRejected,
+	grpcOffer.OfferStatus_OS_WITHDRAWN:   OfferStatusWithdrawn,
+	grpcOffer.OfferStatus_OS_EXPIRED:     OfferStatusExpired,
+}
+
+type OfferAction string
+
+const (
+	OfferActionAccept  = OfferAction("accept")
+	OfferActionReject  = OfferAction("reject")
+	OfferActionCounter = OfferAction("counter")
+)
+
+var OfferActionToProto = map[OfferAction]grpcOffer.OfferAction{
+	OfferActionAccept:  grpcOffer.OfferAction_OA_ACCEPT,
+	OfferActionReject:  grpcOffer.OfferAction_OA_REJECT,
+	OfferActionCounter: grpcOffer.OfferAction_OA_COUNTER,
+}
+
+type LoanStatus string
+
+const (
+	LoanStatusUnspecified = LoanStatus("")
+	LoanStatusActive      = LoanStatus("active")
+	LoanStatusReturned    = LoanStatus("returned")
+)
+
+var LoanStatusFromProto = map[grpcLoan.LoanStatus]LoanStatus{
+	grpcLoan.LoanStatus_LS_UNSPECIFIED: LoanStatusUnspecified,
+	grpcLoan.LoanStatus_LS_ACTIVE:      LoanStatusActive,
+	grpcLoan.LoanStatus_LS_RETURNED:    LoanStatusReturned,
+}
+
+type Role string
+
+const (
+	RoleUnspecified = Role("")
+	RoleManager     = Role("manager")
+	RoleAdmin       = Role("admin")
+	RoleSupport     = Role("support")
+)
+
+// RoleFromProto maps an unspecified role to manager, users created before roles manage their team
+var RoleFromProto = map[grpcUser.Role]Role{
+	grpcUser.Role_ROLE_UNSPECIFIED: RoleManager,
+	grpcUser.Role_ROLE_MANAGER:     RoleManager,
+	grpcUser.Role_ROLE_ADMIN:       RoleAdmin,
+	grpcUser.Role_ROLE_SUPPORT:     RoleSupport,
+}
+
+var RoleToProto = map[Role]grpcUser.Role{
+	RoleManager: grpcUser.Role_ROLE_MANAGER,
+	RoleAdmin:   grpcUser.Role_ROLE_ADMIN,
+	RoleSupport: grpcUser.Role_ROLE_SUPPORT,
+}
+
+type ApiKeyScope string
+
+const (
+	// ApiKeyScopeRead allows reading, GET and HEAD requests
+	ApiKeyScopeRead = ApiKeyScope("read")
+	// ApiKeyScopeTrade allows reading and the requests that change the team, buying, bidding, listing and offers
+	ApiKeyScopeTrade = ApiKeyScope("trade")
+)
+
+var ApiKeyScopes = map[ApiKeyScope]bool{
+	ApiKeyScopeRead:  true,
+	ApiKeyScopeTrade: true,
+}